@@ -0,0 +1,135 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+type Block struct {
+	Created int64  `json:"created,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+type InputGetBlocks struct {
+	StartTime int64
+	EndTime   int64
+	Limit     int
+	Offset    int
+	Email     string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/blocks-api/retrieve-all-blocks
+func (c *Client) GetBlocks(ctx context.Context, input *InputGetBlocks) ([]*Block, error) {
+	u, err := url.Parse("/suppression/blocks")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.StartTime > 0 {
+		q.Set("start_time", strconv.FormatInt(input.StartTime, 10))
+	}
+	if input.EndTime > 0 {
+		q.Set("end_time", strconv.FormatInt(input.EndTime, 10))
+	}
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	if input.Email != "" {
+		q.Set("email", input.Email)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*Block{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// AllBlocks iterates over every entry, advancing offset by limit.
+func (c *Client) AllBlocks(ctx context.Context, input *InputGetBlocks) iter.Seq2[*Block, error] {
+	in := InputGetBlocks{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*Block] {
+		in := in
+		return func(ctx context.Context) ([]*Block, bool, error) {
+			r, err := c.GetBlocks(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/blocks-api/retrieve-a-specific-block
+func (c *Client) GetBlock(ctx context.Context, email string) ([]*Block, error) {
+	path := fmt.Sprintf("/suppression/blocks/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*Block{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputDeleteBlocks struct {
+	DeleteAll bool     `json:"delete_all,omitempty"`
+	Emails    []string `json:"emails,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/blocks-api/delete-blocks
+func (c *Client) DeleteBlocks(ctx context.Context, input *InputDeleteBlocks) error {
+	req, err := c.NewRequest("DELETE", "/suppression/blocks", input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/blocks-api/delete-a-specific-block
+func (c *Client) DeleteBlock(ctx context.Context, email string) error {
+	path := fmt.Sprintf("/suppression/blocks/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
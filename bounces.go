@@ -0,0 +1,135 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+type Bounce struct {
+	Created int64  `json:"created,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+type InputGetBounces struct {
+	StartTime int64
+	EndTime   int64
+	Limit     int
+	Offset    int
+	Email     string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/bounces-api/retrieve-all-bounces
+func (c *Client) GetBounces(ctx context.Context, input *InputGetBounces) ([]*Bounce, error) {
+	u, err := url.Parse("/suppression/bounces")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.StartTime > 0 {
+		q.Set("start_time", strconv.FormatInt(input.StartTime, 10))
+	}
+	if input.EndTime > 0 {
+		q.Set("end_time", strconv.FormatInt(input.EndTime, 10))
+	}
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	if input.Email != "" {
+		q.Set("email", input.Email)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*Bounce{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// AllBounces iterates over every entry, advancing offset by limit.
+func (c *Client) AllBounces(ctx context.Context, input *InputGetBounces) iter.Seq2[*Bounce, error] {
+	in := InputGetBounces{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*Bounce] {
+		in := in
+		return func(ctx context.Context) ([]*Bounce, bool, error) {
+			r, err := c.GetBounces(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/bounces-api/retrieve-a-bounce
+func (c *Client) GetBounce(ctx context.Context, email string) ([]*Bounce, error) {
+	path := fmt.Sprintf("/suppression/bounces/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*Bounce{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputDeleteBounces struct {
+	DeleteAll bool     `json:"delete_all,omitempty"`
+	Emails    []string `json:"emails,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/bounces-api/delete-bounces
+func (c *Client) DeleteBounces(ctx context.Context, input *InputDeleteBounces) error {
+	req, err := c.NewRequest("DELETE", "/suppression/bounces", input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/bounces-api/delete-a-bounce
+func (c *Client) DeleteBounce(ctx context.Context, email string) error {
+	path := fmt.Sprintf("/suppression/bounces/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
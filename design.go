@@ -3,6 +3,9 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
 )
 
 type Design struct {
@@ -26,9 +29,36 @@ type OutputGetDesigns struct {
 	Metadata _Metadata `json:"_metadata,omitempty"`
 }
 
+type InputGetDesigns struct {
+	PageSize  int
+	PageToken string
+	Summary   *bool
+}
+
 // see: https://docs.sendgrid.com/api-reference/designs-api/list-designs
 func (c *Client) GetDesigns(ctx context.Context) (*OutputGetDesigns, error) {
-	req, err := c.NewRequest("GET", "/designs", nil)
+	return c.getDesigns(ctx, &InputGetDesigns{})
+}
+
+func (c *Client) getDesigns(ctx context.Context, input *InputGetDesigns) (*OutputGetDesigns, error) {
+	u, err := url.Parse("/designs")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	if input.Summary != nil {
+		q.Set("summary", strconv.FormatBool(*input.Summary))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +71,30 @@ func (c *Client) GetDesigns(ctx context.Context) (*OutputGetDesigns, error) {
 	return r, nil
 }
 
+// AllDesigns iterates over every design, following _metadata.next across pages.
+func (c *Client) AllDesigns(ctx context.Context, input *InputGetDesigns) iter.Seq2[*Design, error] {
+	in := InputGetDesigns{}
+	if input != nil {
+		in = *input
+	}
+	if in.PageSize == 0 {
+		in.PageSize = defaultPageSize
+	}
+
+	// report pages under the operation of the public single-page method
+	return paginate(WithOperation(ctx, "GetDesigns"), func() pageFetcher[*Design] {
+		in := in
+		return func(ctx context.Context) ([]*Design, bool, error) {
+			r, err := c.getDesigns(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.PageToken = pageToken(r.Metadata.Next)
+			return r.Result, in.PageToken != "", nil
+		}
+	})
+}
+
 type OutputGetDesign struct {
 	ID                   string   `json:"id,omitempty"`
 	UpdatedAt            string   `json:"updated_at,omitempty"`
@@ -305,3 +305,31 @@ func TestDeleteDesign_Failed(t *testing.T) {
 		t.Fatal("expected an error but got nil")
 	}
 }
+
+func TestAllDesigns(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/designs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		body := `{"result":[{"id":"1"}],"_metadata":{"next":"https://api.sendgrid.com/v3/designs?page_token=token"}}`
+		if r.URL.Query().Get("page_token") == "token" {
+			body = `{"result":[{"id":"2"}],"_metadata":{}}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []string{}
+	for design, err := range client.AllDesigns(context.TODO(), nil) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, design.ID)
+	}
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+	}
+}
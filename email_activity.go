@@ -0,0 +1,250 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// status of a message
+const (
+	MessageStatusProcessed    = "processed"
+	MessageStatusDelivered    = "delivered"
+	MessageStatusNotDelivered = "not_delivered"
+)
+
+// MessageQuery builds a query of the Email Activity query language. Its
+// conditions are joined with AND.
+//
+//	q := sendgrid.NewMessageQuery().
+//		ToEmail("user@example.com").
+//		Status(sendgrid.MessageStatusNotDelivered).
+//		LastEventTimeBetween(start, end)
+type MessageQuery struct {
+	conditions []string
+}
+
+// NewMessageQuery returns an empty query, which matches every message.
+func NewMessageQuery() *MessageQuery {
+	return &MessageQuery{}
+}
+
+// messageQueryString quotes s as a string literal of the query language.
+func messageQueryString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func messageQueryTimestamp(t time.Time) string {
+	return "TIMESTAMP " + messageQueryString(t.UTC().Format(time.RFC3339))
+}
+
+func (q *MessageQuery) equal(field, value string) *MessageQuery {
+	q.conditions = append(q.conditions, field+"="+messageQueryString(value))
+	return q
+}
+
+// MsgID matches the message with the given ID.
+func (q *MessageQuery) MsgID(id string) *MessageQuery {
+	return q.equal("msg_id", id)
+}
+
+// Status matches messages in status, one of the MessageStatus constants.
+func (q *MessageQuery) Status(status string) *MessageQuery {
+	return q.equal("status", status)
+}
+
+// ToEmail matches messages sent to email.
+func (q *MessageQuery) ToEmail(email string) *MessageQuery {
+	return q.equal("to_email", email)
+}
+
+// FromEmail matches messages sent from email.
+func (q *MessageQuery) FromEmail(email string) *MessageQuery {
+	return q.equal("from_email", email)
+}
+
+// Subject matches messages whose subject is exactly subject.
+func (q *MessageQuery) Subject(subject string) *MessageQuery {
+	return q.equal("subject", subject)
+}
+
+// LastEventTimeBetween matches messages whose last event occurred from start to end inclusive.
+func (q *MessageQuery) LastEventTimeBetween(start, end time.Time) *MessageQuery {
+	q.conditions = append(q.conditions, "last_event_time BETWEEN "+messageQueryTimestamp(start)+" AND "+messageQueryTimestamp(end))
+	return q
+}
+
+// LastEventTimeAfter matches messages whose last event occurred after t.
+func (q *MessageQuery) LastEventTimeAfter(t time.Time) *MessageQuery {
+	q.conditions = append(q.conditions, "last_event_time>"+messageQueryTimestamp(t))
+	return q
+}
+
+// LastEventTimeBefore matches messages whose last event occurred before t.
+func (q *MessageQuery) LastEventTimeBefore(t time.Time) *MessageQuery {
+	q.conditions = append(q.conditions, "last_event_time<"+messageQueryTimestamp(t))
+	return q
+}
+
+// Where adds a raw condition, e.g. `clicks>0`. It is wrapped in parentheses,
+// so an OR in condition does not change the meaning of the other conditions.
+func (q *MessageQuery) Where(condition string) *MessageQuery {
+	q.conditions = append(q.conditions, "("+condition+")")
+	return q
+}
+
+// String returns the query, to be used as InputGetMessages.Query.
+func (q *MessageQuery) String() string {
+	return strings.Join(q.conditions, " AND ")
+}
+
+type Message struct {
+	FromEmail     string `json:"from_email,omitempty"`
+	MsgID         string `json:"msg_id,omitempty"`
+	Subject       string `json:"subject,omitempty"`
+	ToEmail       string `json:"to_email,omitempty"`
+	Status        string `json:"status,omitempty"`
+	OpensCount    int64  `json:"opens_count,omitempty"`
+	ClicksCount   int64  `json:"clicks_count,omitempty"`
+	LastEventTime string `json:"last_event_time,omitempty"`
+}
+
+type InputGetMessages struct {
+	// Query is built with NewMessageQuery, or written in the query language.
+	Query string
+	// Limit is the number of messages to return, from 1 to 1000.
+	Limit int
+}
+
+type OutputGetMessages struct {
+	Messages []*Message `json:"messages,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/email-activity/filter-all-messages
+func (c *Client) GetMessages(ctx context.Context, input *InputGetMessages) (*OutputGetMessages, error) {
+	u, err := url.Parse("/messages")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.Query != "" {
+		q.Set("query", input.Query)
+	}
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetMessages)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type MessageEvent struct {
+	EventName     string `json:"event_name,omitempty"`
+	Processed     string `json:"processed,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+	AttemptNum    int64  `json:"attempt_num,omitempty"`
+	URL           string `json:"url,omitempty"`
+	BounceType    string `json:"bounce_type,omitempty"`
+	HTTPUserAgent string `json:"http_user_agent,omitempty"`
+	MXServer      string `json:"mx_server,omitempty"`
+}
+
+type OutputGetMessage struct {
+	FromEmail      string            `json:"from_email,omitempty"`
+	MsgID          string            `json:"msg_id,omitempty"`
+	Subject        string            `json:"subject,omitempty"`
+	ToEmail        string            `json:"to_email,omitempty"`
+	Status         string            `json:"status,omitempty"`
+	TemplateID     string            `json:"template_id,omitempty"`
+	ASMGroupID     int64             `json:"asm_group_id,omitempty"`
+	Teammate       string            `json:"teammate,omitempty"`
+	APIKeyID       string            `json:"api_key_id,omitempty"`
+	Events         []*MessageEvent   `json:"events,omitempty"`
+	OriginatingIP  string            `json:"originating_ip,omitempty"`
+	Categories     []string          `json:"categories,omitempty"`
+	UniqueArgs     map[string]string `json:"unique_args,omitempty"`
+	OutboundIP     string            `json:"outbound_ip,omitempty"`
+	OutboundIPType string            `json:"outbound_ip_type,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/email-activity/filter-messages-by-message-id
+func (c *Client) GetMessage(ctx context.Context, msgID string) (*OutputGetMessage, error) {
+	path := fmt.Sprintf("/messages/%s", msgID)
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetMessage)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputRequestMessagesDownload struct {
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// RequestMessagesDownload requests a CSV of the messages matching query. The
+// download link is emailed to the user and read with GetMessagesDownload.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/email-activity/request-a-csv
+func (c *Client) RequestMessagesDownload(ctx context.Context, query string) (*OutputRequestMessagesDownload, error) {
+	u, err := url.Parse("/messages/download")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if query != "" {
+		q.Set("query", query)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputRequestMessagesDownload)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputGetMessagesDownload struct {
+	PresignedURL string `json:"presigned_url,omitempty"`
+	CSV          string `json:"csv,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/email-activity/download-csv
+func (c *Client) GetMessagesDownload(ctx context.Context, downloadUUID string) (*OutputGetMessagesDownload, error) {
+	path := fmt.Sprintf("/messages/download/%s", downloadUUID)
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetMessagesDownload)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,216 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageQuery(t *testing.T) {
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	end := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	q := NewMessageQuery().
+		ToEmail("user@example.com").
+		Status(MessageStatusNotDelivered).
+		Subject(`say "hi" \o/`).
+		LastEventTimeBetween(start, end)
+
+	assert.Equal(t,
+		`to_email="user@example.com" AND status="not_delivered" AND subject="say \"hi\" \\o/" AND `+
+			`last_event_time BETWEEN TIMESTAMP "2026-01-01T00:00:00Z" AND TIMESTAMP "2026-01-02T00:00:00Z"`,
+		q.String())
+
+	q = NewMessageQuery().
+		MsgID("msg-1").
+		FromEmail("from@example.com").
+		LastEventTimeAfter(start).
+		LastEventTimeBefore(end).
+		Where("clicks>0")
+
+	assert.Equal(t,
+		`msg_id="msg-1" AND from_email="from@example.com" AND `+
+			`last_event_time>TIMESTAMP "2026-01-01T00:00:00Z" AND last_event_time<TIMESTAMP "2026-01-02T00:00:00Z" AND (clicks>0)`,
+		q.String())
+
+	q = NewMessageQuery().
+		ToEmail("user@example.com").
+		Where(`status="delivered" OR clicks>0`)
+
+	assert.Equal(t, `to_email="user@example.com" AND (status="delivered" OR clicks>0)`, q.String())
+
+	assert.Empty(t, NewMessageQuery().String())
+}
+
+func TestGetMessages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	query := NewMessageQuery().ToEmail("user@example.com").String()
+
+	mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, query, r.URL.Query().Get("query"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		if _, err := fmt.Fprint(w, `{
+			"messages": [{
+				"from_email": "from@example.com",
+				"msg_id": "msg-1",
+				"subject": "hello",
+				"to_email": "user@example.com",
+				"status": "delivered",
+				"opens_count": 1,
+				"clicks_count": 2,
+				"last_event_time": "2026-01-01T00:00:00Z"
+			}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetMessages(context.TODO(), &InputGetMessages{Query: query, Limit: 10})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetMessages{
+		Messages: []*Message{
+			{
+				FromEmail:     "from@example.com",
+				MsgID:         "msg-1",
+				Subject:       "hello",
+				ToEmail:       "user@example.com",
+				Status:        MessageStatusDelivered,
+				OpensCount:    1,
+				ClicksCount:   2,
+				LastEventTime: "2026-01-01T00:00:00Z",
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetMessages_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.GetMessages(context.TODO(), &InputGetMessages{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetMessage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/messages/msg-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{
+			"msg_id": "msg-1",
+			"status": "not_delivered",
+			"asm_group_id": 12,
+			"events": [{"event_name": "bounced", "processed": "2026-01-01T00:00:00Z", "reason": "550 unknown user", "bounce_type": "bounce"}],
+			"categories": ["news"],
+			"unique_args": {"user_id": "42"}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetMessage(context.TODO(), "msg-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetMessage{
+		MsgID:      "msg-1",
+		Status:     MessageStatusNotDelivered,
+		ASMGroupID: 12,
+		Events: []*MessageEvent{
+			{EventName: "bounced", Processed: "2026-01-01T00:00:00Z", Reason: "550 unknown user", BounceType: "bounce"},
+		},
+		Categories: []string{"news"},
+		UniqueArgs: map[string]string{"user_id": "42"},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestRequestMessagesDownload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/messages/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		assert.Equal(t, `status="delivered"`, r.URL.Query().Get("query"))
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := fmt.Fprint(w, `{"status": "pending", "message": "An email will be sent"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.RequestMessagesDownload(context.TODO(), NewMessageQuery().Status(MessageStatusDelivered).String())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputRequestMessagesDownload{Status: "pending", Message: "An email will be sent"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetMessagesDownload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/messages/download/uuid-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"presigned_url": "https://example.com/messages.csv.gz", "csv": "messages.csv.gz"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetMessagesDownload(context.TODO(), "uuid-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetMessagesDownload{PresignedURL: "https://example.com/messages.csv.gz", CSV: "messages.csv.gz"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetMessagesDownload_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/messages/download/uuid-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetMessagesDownload(context.TODO(), "uuid-1")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
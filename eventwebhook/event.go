@@ -0,0 +1,221 @@
+// Package eventwebhook decodes and verifies the payloads SendGrid posts to
+// the URL configured with sendgrid.Client.CreateEventWebhook.
+package eventwebhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type EventType string
+
+const (
+	EventProcessed        EventType = "processed"
+	EventDelivered        EventType = "delivered"
+	EventOpen             EventType = "open"
+	EventClick            EventType = "click"
+	EventBounce           EventType = "bounce"
+	EventDropped          EventType = "dropped"
+	EventDeferred         EventType = "deferred"
+	EventSpamReport       EventType = "spamreport"
+	EventUnsubscribe      EventType = "unsubscribe"
+	EventGroupUnsubscribe EventType = "group_unsubscribe"
+	EventGroupResubscribe EventType = "group_resubscribe"
+)
+
+// Event is implemented by every typed event. Use a type switch to access
+// the fields specific to an event type.
+type Event interface {
+	Base() *BaseEvent
+}
+
+// BaseEvent holds the fields shared by every event type.
+type BaseEvent struct {
+	Email               string     `json:"email"`
+	Timestamp           int64      `json:"timestamp"`
+	Event               EventType  `json:"event"`
+	SGEventID           string     `json:"sg_event_id"`
+	SGMessageID         string     `json:"sg_message_id"`
+	SMTPID              string     `json:"smtp-id,omitempty"`
+	Category            Categories `json:"category,omitempty"`
+	ASMGroupID          int64      `json:"asm_group_id,omitempty"`
+	MarketingCampaignID int64      `json:"marketing_campaign_id,omitempty"`
+	SingleSendID        string     `json:"singlesend_id,omitempty"`
+	TemplateID          string     `json:"template_id,omitempty"`
+
+	// Raw is the undecoded event, which also carries any custom_args of the message.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (e *BaseEvent) Base() *BaseEvent { return e }
+
+// Time returns Timestamp as a time.Time.
+func (e *BaseEvent) Time() time.Time {
+	return time.Unix(e.Timestamp, 0)
+}
+
+// Categories decodes the category field, which SendGrid sends either as a
+// string or as an array of strings.
+type Categories []string
+
+func (c *Categories) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*c = Categories{s}
+		return nil
+	}
+
+	var ss []string
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return err
+	}
+	*c = ss
+	return nil
+}
+
+type Pool struct {
+	Name string `json:"name,omitempty"`
+	ID   int64  `json:"id,omitempty"`
+}
+
+type ProcessedEvent struct {
+	BaseEvent
+	Pool   *Pool `json:"pool,omitempty"`
+	SendAt int64 `json:"send_at,omitempty"`
+}
+
+type DeliveredEvent struct {
+	BaseEvent
+	Response string `json:"response,omitempty"`
+	IP       string `json:"ip,omitempty"`
+	TLS      int    `json:"tls,omitempty"`
+	CertErr  int    `json:"cert_err,omitempty"`
+}
+
+type OpenEvent struct {
+	BaseEvent
+	UserAgent     string `json:"useragent,omitempty"`
+	IP            string `json:"ip,omitempty"`
+	SGMachineOpen bool   `json:"sg_machine_open,omitempty"`
+}
+
+type URLOffset struct {
+	Index int    `json:"index"`
+	Type  string `json:"type,omitempty"`
+}
+
+type ClickEvent struct {
+	BaseEvent
+	URL       string     `json:"url,omitempty"`
+	URLOffset *URLOffset `json:"url_offset,omitempty"`
+	UserAgent string     `json:"useragent,omitempty"`
+	IP        string     `json:"ip,omitempty"`
+}
+
+type BounceEvent struct {
+	BaseEvent
+	Reason               string `json:"reason,omitempty"`
+	Status               string `json:"status,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	BounceClassification string `json:"bounce_classification,omitempty"`
+	IP                   string `json:"ip,omitempty"`
+	TLS                  int    `json:"tls,omitempty"`
+}
+
+type DroppedEvent struct {
+	BaseEvent
+	Reason string `json:"reason,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type DeferredEvent struct {
+	BaseEvent
+	Response string `json:"response,omitempty"`
+	Attempt  string `json:"attempt,omitempty"`
+	IP       string `json:"ip,omitempty"`
+	TLS      int    `json:"tls,omitempty"`
+}
+
+type SpamReportEvent struct {
+	BaseEvent
+}
+
+type UnsubscribeEvent struct {
+	BaseEvent
+}
+
+type GroupUnsubscribeEvent struct {
+	BaseEvent
+	UserAgent string `json:"useragent,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+type GroupResubscribeEvent struct {
+	BaseEvent
+	UserAgent string `json:"useragent,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// UnknownEvent is returned for event types this package does not know yet.
+type UnknownEvent struct {
+	BaseEvent
+}
+
+func newEvent(t EventType) Event {
+	switch t {
+	case EventProcessed:
+		return new(ProcessedEvent)
+	case EventDelivered:
+		return new(DeliveredEvent)
+	case EventOpen:
+		return new(OpenEvent)
+	case EventClick:
+		return new(ClickEvent)
+	case EventBounce:
+		return new(BounceEvent)
+	case EventDropped:
+		return new(DroppedEvent)
+	case EventDeferred:
+		return new(DeferredEvent)
+	case EventSpamReport:
+		return new(SpamReportEvent)
+	case EventUnsubscribe:
+		return new(UnsubscribeEvent)
+	case EventGroupUnsubscribe:
+		return new(GroupUnsubscribeEvent)
+	case EventGroupResubscribe:
+		return new(GroupResubscribeEvent)
+	}
+	return new(UnknownEvent)
+}
+
+// Parse decodes the JSON array posted by the event webhook into typed events.
+func Parse(body []byte) ([]Event, error) {
+	raws := []json.RawMessage{}
+	if err := json.Unmarshal(body, &raws); err != nil {
+		return nil, errors.Wrap(err, "failed to decode events")
+	}
+
+	events := make([]Event, 0, len(raws))
+	for i, raw := range raws {
+		var head struct {
+			Event EventType `json:"event"`
+		}
+		if err := json.Unmarshal(raw, &head); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode event %d", i)
+		}
+
+		e := newEvent(head.Event)
+		if err := json.Unmarshal(raw, e); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s event %d", head.Event, i)
+		}
+		e.Base().Raw = raw
+		events = append(events, e)
+	}
+
+	return events, nil
+}
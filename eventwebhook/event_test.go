@@ -0,0 +1,51 @@
+package eventwebhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	events, err := Parse([]byte(`[
+		{"email":"a@example.com","timestamp":1700000000,"event":"processed","sg_event_id":"1","sg_message_id":"m","category":"cat","pool":{"name":"pool","id":1}},
+		{"email":"a@example.com","timestamp":1700000000,"event":"delivered","response":"250 OK","tls":1},
+		{"email":"a@example.com","timestamp":1700000000,"event":"open","useragent":"ua","sg_machine_open":true},
+		{"email":"a@example.com","timestamp":1700000000,"event":"click","url":"https://example.com","url_offset":{"index":0,"type":"html"},"category":["a","b"]},
+		{"email":"a@example.com","timestamp":1700000000,"event":"bounce","reason":"500 unknown recipient","status":"5.0.0","type":"bounce"},
+		{"email":"a@example.com","timestamp":1700000000,"event":"dropped","reason":"Bounced Address"},
+		{"email":"a@example.com","timestamp":1700000000,"event":"deferred","attempt":"5"},
+		{"email":"a@example.com","timestamp":1700000000,"event":"spamreport"},
+		{"email":"a@example.com","timestamp":1700000000,"event":"unsubscribe"},
+		{"email":"a@example.com","timestamp":1700000000,"event":"group_unsubscribe","asm_group_id":10},
+		{"email":"a@example.com","timestamp":1700000000,"event":"group_resubscribe","asm_group_id":10},
+		{"email":"a@example.com","timestamp":1700000000,"event":"unknown","custom":"value"}
+	]`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Len(t, events, 12)
+	assert.Equal(t, &Pool{Name: "pool", ID: 1}, events[0].(*ProcessedEvent).Pool)
+	assert.Equal(t, Categories{"cat"}, events[0].Base().Category)
+	assert.Equal(t, "250 OK", events[1].(*DeliveredEvent).Response)
+	assert.True(t, events[2].(*OpenEvent).SGMachineOpen)
+	assert.Equal(t, "https://example.com", events[3].(*ClickEvent).URL)
+	assert.Equal(t, Categories{"a", "b"}, events[3].Base().Category)
+	assert.Equal(t, "5.0.0", events[4].(*BounceEvent).Status)
+	assert.Equal(t, "Bounced Address", events[5].(*DroppedEvent).Reason)
+	assert.Equal(t, "5", events[6].(*DeferredEvent).Attempt)
+	assert.IsType(t, &SpamReportEvent{}, events[7])
+	assert.IsType(t, &UnsubscribeEvent{}, events[8])
+	assert.Equal(t, int64(10), events[9].(*GroupUnsubscribeEvent).ASMGroupID)
+	assert.IsType(t, &GroupResubscribeEvent{}, events[10])
+	assert.IsType(t, &UnknownEvent{}, events[11])
+	assert.Contains(t, string(events[11].Base().Raw), `"custom":"value"`)
+	assert.Equal(t, int64(1700000000), events[0].Base().Time().Unix())
+}
+
+func TestParse_Failed(t *testing.T) {
+	if _, err := Parse([]byte(`{"event":"open"}`)); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
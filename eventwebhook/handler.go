@@ -0,0 +1,150 @@
+package eventwebhook
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const defaultMaxBodySize = 10 << 20
+
+// Handler is an http.Handler that verifies and decodes event webhook
+// requests, then dispatches every event to a callback and/or a channel.
+//
+// A callback error, or a canceled request while sending to the channel,
+// results in a 500 response so that SendGrid retries the batch.
+type Handler struct {
+	publicKey   *ecdsa.PublicKey
+	callback    func(context.Context, Event) error
+	events      chan<- Event
+	maxBodySize int64
+	tolerance   time.Duration
+}
+
+// Option defines an option for a Handler
+type Option func(*Handler)
+
+// OptionCallback - call fn for each received event.
+func OptionCallback(fn func(ctx context.Context, e Event) error) func(*Handler) {
+	return func(h *Handler) {
+		h.callback = fn
+	}
+}
+
+// OptionChannel - send each received event to ch.
+func OptionChannel(ch chan<- Event) func(*Handler) {
+	return func(h *Handler) {
+		h.events = ch
+	}
+}
+
+// OptionMaxBodySize - limit the size of accepted request bodies. Defaults to 10MB.
+func OptionMaxBodySize(n int64) func(*Handler) {
+	return func(h *Handler) {
+		h.maxBodySize = n
+	}
+}
+
+// OptionTolerance - reject requests whose signed timestamp is older than d.
+func OptionTolerance(d time.Duration) func(*Handler) {
+	return func(h *Handler) {
+		h.tolerance = d
+	}
+}
+
+// NewHandler builds a Handler verifying requests with the base64 encoded
+// public key of the signed event webhook.
+func NewHandler(publicKey string, options ...Option) (*Handler, error) {
+	key, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Handler{
+		publicKey:   key,
+		maxBodySize: defaultMaxBodySize,
+	}
+
+	for _, opt := range options {
+		opt(h)
+	}
+
+	return h, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	timestamp := r.Header.Get(TimestampHeader)
+	if err := h.verify(body, r.Header.Get(SignatureHeader), timestamp); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	events, err := Parse(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	for _, e := range events {
+		if err := h.dispatch(ctx, e); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) verify(body []byte, signature, timestamp string) error {
+	if signature == "" || timestamp == "" {
+		return ErrInvalidSignature
+	}
+
+	if h.tolerance > 0 {
+		ts, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return ErrInvalidSignature
+		}
+		if time.Since(time.Unix(ts, 0)) > h.tolerance {
+			return errors.New("event webhook timestamp is too old")
+		}
+	}
+
+	return VerifySignature(h.publicKey, body, signature, timestamp)
+}
+
+func (h *Handler) dispatch(ctx context.Context, e Event) error {
+	if h.callback != nil {
+		if err := h.callback(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	if h.events != nil {
+		select {
+		case h.events <- e:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
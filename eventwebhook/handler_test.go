@@ -0,0 +1,114 @@
+package eventwebhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const payload = `[{"email":"a@example.com","timestamp":1700000000,"event":"delivered"},{"email":"b@example.com","timestamp":1700000000,"event":"open"}]`
+
+func newRequest(t *testing.T, signature, timestamp string) *http.Request {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(payload))
+	r.Header.Set(SignatureHeader, signature)
+	r.Header.Set(TimestampHeader, timestamp)
+	return r
+}
+
+func TestHandler(t *testing.T) {
+	key, publicKey := generateKey(t)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	received := []Event{}
+	ch := make(chan Event, 2)
+	h, err := NewHandler(publicKey,
+		OptionCallback(func(ctx context.Context, e Event) error {
+			received = append(received, e)
+			return nil
+		}),
+		OptionChannel(ch),
+		OptionTolerance(time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest(t, sign(t, key, []byte(payload), timestamp), timestamp))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, received, 2)
+	assert.IsType(t, &DeliveredEvent{}, <-ch)
+	assert.IsType(t, &OpenEvent{}, <-ch)
+}
+
+func TestHandler_Failed(t *testing.T) {
+	key, publicKey := generateKey(t)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	old := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	tests := []struct {
+		name     string
+		request  func() *http.Request
+		callback func(context.Context, Event) error
+		code     int
+	}{
+		{
+			name:    "method not allowed",
+			request: func() *http.Request { return httptest.NewRequest(http.MethodGet, "/events", nil) },
+			code:    http.StatusMethodNotAllowed,
+		},
+		{
+			name:    "missing signature",
+			request: func() *http.Request { return newRequest(t, "", now) },
+			code:    http.StatusUnauthorized,
+		},
+		{
+			name:    "invalid signature",
+			request: func() *http.Request { return newRequest(t, sign(t, key, []byte("[]"), now), now) },
+			code:    http.StatusUnauthorized,
+		},
+		{
+			name:    "timestamp too old",
+			request: func() *http.Request { return newRequest(t, sign(t, key, []byte(payload), old), old) },
+			code:    http.StatusUnauthorized,
+		},
+		{
+			name:     "callback failed",
+			request:  func() *http.Request { return newRequest(t, sign(t, key, []byte(payload), now), now) },
+			callback: func(context.Context, Event) error { return errors.New("failed") },
+			code:     http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := []Option{OptionTolerance(time.Minute)}
+			if tt.callback != nil {
+				options = append(options, OptionCallback(tt.callback))
+			}
+			h, err := NewHandler(publicKey, options...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, tt.request())
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+}
+
+func TestNewHandler_Failed(t *testing.T) {
+	if _, err := NewHandler(""); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
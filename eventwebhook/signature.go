@@ -0,0 +1,56 @@
+package eventwebhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+)
+
+// Headers carrying the signature of a signed event webhook request.
+const (
+	SignatureHeader = "X-Twilio-Email-Event-Webhook-Signature"
+	TimestampHeader = "X-Twilio-Email-Event-Webhook-Timestamp"
+)
+
+var ErrInvalidSignature = errors.New("invalid event webhook signature")
+
+// ParsePublicKey parses the base64 encoded public key returned as PublicKey
+// by sendgrid.Client.GetEventWebhook.
+func ParsePublicKey(s string) (*ecdsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an ECDSA key")
+	}
+	return key, nil
+}
+
+// VerifySignature checks that signature was produced by the key for the
+// timestamp followed by the raw request body.
+func VerifySignature(key *ecdsa.PublicKey, payload []byte, signature, timestamp string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	h := sha256.New()
+	h.Write([]byte(timestamp))
+	h.Write(payload)
+
+	if !ecdsa.VerifyASN1(key, h.Sum(nil), sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
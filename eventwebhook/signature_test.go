@@ -0,0 +1,61 @@
+package eventwebhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func generateKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, base64.StdEncoding.EncodeToString(der)
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, payload []byte, timestamp string) string {
+	t.Helper()
+	h := sha256.Sum256(append([]byte(timestamp), payload...))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestVerifySignature(t *testing.T) {
+	key, publicKey := generateKey(t)
+	pub, err := ParsePublicKey(publicKey)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	payload := []byte(`[{"event":"open"}]`)
+	signature := sign(t, key, payload, "1700000000")
+
+	assert.NoError(t, VerifySignature(pub, payload, signature, "1700000000"))
+	assert.ErrorIs(t, VerifySignature(pub, payload, signature, "1700000001"), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifySignature(pub, []byte(`[]`), signature, "1700000000"), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifySignature(pub, payload, "not base64", "1700000000"), ErrInvalidSignature)
+}
+
+func TestParsePublicKey_Failed(t *testing.T) {
+	if _, err := ParsePublicKey("not base64"); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+	if _, err := ParsePublicKey(base64.StdEncoding.EncodeToString([]byte("dummy"))); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+func main() {
+	if err := handler(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func handler() error {
+	apiKey := os.Getenv("SENDGRID_API_KEY")
+
+	c := sendgrid.New(apiKey, sendgrid.OptionDebug(true))
+	r, err := c.AddGlobalSuppressions(context.TODO(), &sendgrid.InputAddGlobalSuppressions{
+		RecipientEmails: []string{"dummy@example.com"},
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("global suppressions: %#v", r)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+func main() {
+	if err := handler(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func handler() error {
+	apiKey := os.Getenv("SENDGRID_API_KEY")
+
+	c := sendgrid.New(apiKey, sendgrid.OptionDebug(true))
+	for template, err := range c.AllTemplates(context.TODO(), &sendgrid.InputGetTemplates{
+		Generations: "dynamic",
+	}) {
+		if err != nil {
+			return err
+		}
+		log.Printf("template: %#v", template)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+func main() {
+	if err := handler(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// audit logs every mutating call with its operation name, status and latency.
+func audit(next sendgrid.Doer) sendgrid.Doer {
+	return sendgrid.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			return next.Do(req)
+		}
+
+		start := time.Now()
+		resp, err := next.Do(req)
+		if err != nil {
+			log.Printf("audit: %s failed: %s", sendgrid.OperationFromContext(req.Context()), err)
+			return resp, err
+		}
+		log.Printf("audit: %s %s %s -> %d (%s)", sendgrid.OperationFromContext(req.Context()), req.Method, req.URL.Path, resp.StatusCode, time.Since(start))
+		return resp, nil
+	})
+}
+
+func handler() error {
+	apiKey := os.Getenv("SENDGRID_API_KEY")
+
+	c := sendgrid.New(apiKey, sendgrid.OptionMiddleware(audit))
+	r, err := c.CreateTemplate(context.TODO(), &sendgrid.InputCreateTemplate{
+		Name:       "dummy",
+		Generation: "dynamic",
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("template: %#v", r)
+
+	return nil
+}
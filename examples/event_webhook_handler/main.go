@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/sendgrid/eventwebhook"
+)
+
+func main() {
+	if err := handler(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func handler() error {
+	apiKey := os.Getenv("SENDGRID_API_KEY")
+	webhookID := os.Getenv("SENDGRID_EVENT_WEBHOOK_ID")
+
+	c := sendgrid.New(apiKey)
+	webhook, err := c.GetEventWebhook(context.TODO(), webhookID)
+	if err != nil {
+		return err
+	}
+
+	h, err := eventwebhook.NewHandler(webhook.PublicKey, eventwebhook.OptionCallback(func(ctx context.Context, e eventwebhook.Event) error {
+		switch e := e.(type) {
+		case *eventwebhook.BounceEvent:
+			log.Printf("bounce: %s %s", e.Email, e.Reason)
+		default:
+			log.Printf("%s: %s", e.Base().Event, e.Base().Email)
+		}
+		return nil
+	}))
+	if err != nil {
+		return err
+	}
+
+	return http.ListenAndServe(":8080", h)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+func main() {
+	if err := handler(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func handler() error {
+	apiKey := os.Getenv("SENDGRID_API_KEY")
+
+	ctx := context.TODO()
+	c := sendgrid.New(apiKey)
+	r, err := c.ExportContacts(ctx, &sendgrid.InputExportContacts{
+		FileType: "csv",
+	})
+	if err != nil {
+		return err
+	}
+
+	export, err := c.WaitContactExport(ctx, r.ID, 5*time.Second)
+	if err != nil {
+		return err
+	}
+	log.Printf("urls: %v", export.URLs)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+func main() {
+	if err := handler(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func handler() error {
+	apiKey := os.Getenv("SENDGRID_API_KEY")
+
+	c := sendgrid.New(apiKey, sendgrid.OptionDebug(true))
+	bounces, err := c.GetBounces(context.TODO(), &sendgrid.InputGetBounces{
+		Limit: 10,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, bounce := range bounces {
+		log.Printf("bounce: %#v", bounce)
+	}
+
+	return nil
+}
@@ -18,7 +18,7 @@ func handler() error {
 	apiKey := os.Getenv("SENDGRID_API_KEY")
 
 	c := sendgrid.New(apiKey, sendgrid.OptionDebug(true))
-	r, err := c.GetTeammates(context.TODO())
+	r, err := c.GetTeammates(context.TODO(), &sendgrid.InputGetTeammates{})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+func main() {
+	if err := handler(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func handler() error {
+	apiKey := os.Getenv("SENDGRID_API_KEY")
+
+	c := sendgrid.New(apiKey, sendgrid.OptionDebug(true))
+
+	p := (&sendgrid.Personalization{}).
+		AddTos(&sendgrid.MailAddress{Email: "to@example.com", Name: "To"}).
+		SetDynamicTemplateData("name", "dummy")
+
+	m := sendgrid.NewMailMessage(&sendgrid.MailAddress{Email: "from@example.com"}, "Hello").
+		AddPersonalizations(p).
+		AddContent("text/plain", "Hello, world")
+
+	r, err := c.SendMail(context.TODO(), m)
+	if err != nil {
+		return err
+	}
+	log.Printf("message id: %s", r.MessageID)
+	return nil
+}
@@ -0,0 +1,192 @@
+// Package inboundparse decodes the multipart payloads SendGrid posts to the
+// URL configured with sendgrid.Client.CreateInboundParseWebhook.
+package inboundparse
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/mail"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxMemory is the number of bytes of a payload kept in memory,
+// attachments beyond it are streamed to temporary files.
+const DefaultMaxMemory = 32 << 20
+
+type Envelope struct {
+	To   []string `json:"to"`
+	From string   `json:"from"`
+}
+
+type AttachmentInfo struct {
+	Filename  string `json:"filename,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Type      string `json:"type,omitempty"`
+	ContentID string `json:"content-id,omitempty"`
+}
+
+type Attachment struct {
+	AttachmentInfo
+	// Field is the form field the attachment was posted as, e.g. "attachment1".
+	Field string
+	Size  int64
+
+	header *multipart.FileHeader
+}
+
+// Open returns the content of the attachment, read from memory or from the
+// temporary file it was streamed to.
+func (a *Attachment) Open() (multipart.File, error) {
+	return a.header.Open()
+}
+
+// Email is a decoded inbound parse payload. Raw is only set when the parse
+// setting has SendRaw enabled, in which case RawEmail holds the full MIME
+// message and Text, HTML and Attachments are empty.
+type Email struct {
+	Headers     string
+	DKIM        string
+	ContentIDs  map[string]string
+	To          string
+	From        string
+	Cc          string
+	Subject     string
+	Text        string
+	HTML        string
+	SenderIP    string
+	SPF         string
+	SpamReport  string
+	SpamScore   float64
+	Envelope    Envelope
+	Charsets    map[string]string
+	Attachments []*Attachment
+
+	RawEmail string
+	Raw      *mail.Message
+
+	form *multipart.Form
+}
+
+// Close removes the temporary files attachments were streamed to.
+func (e *Email) Close() error {
+	if e.form == nil {
+		return nil
+	}
+	return e.form.RemoveAll()
+}
+
+// Parse decodes an inbound parse request in either parsed or raw mode.
+// The returned Email must be closed once its attachments are no longer needed.
+func Parse(r *http.Request, maxMemory int64) (*Email, error) {
+	if err := r.ParseMultipartForm(maxMemory); err != nil {
+		return nil, errors.Wrap(err, "failed to parse multipart form")
+	}
+
+	form := r.MultipartForm
+	e := &Email{
+		Headers:    value(form, "headers"),
+		DKIM:       value(form, "dkim"),
+		To:         value(form, "to"),
+		From:       value(form, "from"),
+		Cc:         value(form, "cc"),
+		Subject:    value(form, "subject"),
+		Text:       value(form, "text"),
+		HTML:       value(form, "html"),
+		SenderIP:   value(form, "sender_ip"),
+		SPF:        value(form, "SPF"),
+		SpamReport: value(form, "spam_report"),
+		RawEmail:   value(form, "email"),
+		form:       form,
+	}
+
+	if err := e.decode(); err != nil {
+		_ = e.Close()
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (e *Email) decode() error {
+	form := e.form
+
+	if v := value(form, "spam_score"); v != "" {
+		score, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse spam_score")
+		}
+		e.SpamScore = score
+	}
+
+	if err := unmarshal(form, "envelope", &e.Envelope); err != nil {
+		return err
+	}
+	if err := unmarshal(form, "charsets", &e.Charsets); err != nil {
+		return err
+	}
+	if err := unmarshal(form, "content-ids", &e.ContentIDs); err != nil {
+		return err
+	}
+
+	info := map[string]AttachmentInfo{}
+	if err := unmarshal(form, "attachment-info", &info); err != nil {
+		return err
+	}
+
+	fields := make([]string, 0, len(form.File))
+	for field := range form.File {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		for _, fh := range form.File[field] {
+			a := &Attachment{
+				AttachmentInfo: info[field],
+				Field:          field,
+				Size:           fh.Size,
+				header:         fh,
+			}
+			if a.Filename == "" {
+				a.Filename = fh.Filename
+			}
+			if a.Type == "" {
+				a.Type = fh.Header.Get("Content-Type")
+			}
+			e.Attachments = append(e.Attachments, a)
+		}
+	}
+
+	if e.RawEmail != "" {
+		msg, err := mail.ReadMessage(strings.NewReader(e.RawEmail))
+		if err != nil {
+			return errors.Wrap(err, "failed to parse raw email")
+		}
+		e.Raw = msg
+	}
+
+	return nil
+}
+
+func value(form *multipart.Form, key string) string {
+	if v := form.Value[key]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func unmarshal(form *multipart.Form, key string, dst interface{}) error {
+	v := value(form, key)
+	if v == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(v), dst); err != nil {
+		return errors.Wrapf(err, "failed to parse %s", key)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package inboundparse
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRequest(t *testing.T, fields map[string]string, files map[string]string) *http.Request {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	for k, v := range fields {
+		if err := mw.WriteField(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for k, v := range files {
+		fw, err := mw.CreateFormFile(k, k+".txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(fw, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/inbound", body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	return r
+}
+
+func TestParse(t *testing.T) {
+	r := newRequest(t, map[string]string{
+		"to":              "inbound@example.com",
+		"from":            "Sender <sender@example.com>",
+		"subject":         "hello",
+		"text":            "plain body",
+		"html":            "<p>html body</p>",
+		"sender_ip":       "192.0.2.1",
+		"SPF":             "pass",
+		"spam_score":      "0.1",
+		"envelope":        `{"to":["inbound@example.com"],"from":"sender@example.com"}`,
+		"charsets":        `{"to":"UTF-8","subject":"UTF-8"}`,
+		"attachments":     "1",
+		"attachment-info": `{"attachment1":{"filename":"note.txt","name":"note.txt","type":"text/plain"}}`,
+	}, map[string]string{
+		"attachment1": "attached content",
+	})
+
+	// a zero max memory streams every attachment to disk
+	e, err := Parse(r, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer func() {
+		assert.NoError(t, e.Close())
+	}()
+
+	assert.Equal(t, "inbound@example.com", e.To)
+	assert.Equal(t, "hello", e.Subject)
+	assert.Equal(t, "plain body", e.Text)
+	assert.Equal(t, "<p>html body</p>", e.HTML)
+	assert.Equal(t, 0.1, e.SpamScore)
+	assert.Equal(t, Envelope{To: []string{"inbound@example.com"}, From: "sender@example.com"}, e.Envelope)
+	assert.Equal(t, "UTF-8", e.Charsets["subject"])
+	assert.Nil(t, e.Raw)
+
+	if assert.Len(t, e.Attachments, 1) {
+		a := e.Attachments[0]
+		assert.Equal(t, "attachment1", a.Field)
+		assert.Equal(t, "note.txt", a.Filename)
+		assert.Equal(t, "text/plain", a.Type)
+
+		f, err := a.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		b, err := io.ReadAll(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "attached content", string(b))
+	}
+}
+
+func TestParse_Raw(t *testing.T) {
+	r := newRequest(t, map[string]string{
+		"to":       "inbound@example.com",
+		"from":     "sender@example.com",
+		"envelope": `{"to":["inbound@example.com"],"from":"sender@example.com"}`,
+		"email":    "From: sender@example.com\r\nTo: inbound@example.com\r\nSubject: raw\r\n\r\nraw body\r\n",
+	}, nil)
+
+	e, err := Parse(r, DefaultMaxMemory)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if assert.NotNil(t, e.Raw) {
+		assert.Equal(t, "raw", e.Raw.Header.Get("Subject"))
+		b, err := io.ReadAll(e.Raw.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "raw body\r\n", string(b))
+	}
+}
+
+func TestParse_Failed(t *testing.T) {
+	tests := map[string]*http.Request{
+		"not multipart":    httptest.NewRequest(http.MethodPost, "/inbound", bytes.NewBufferString("dummy")),
+		"invalid envelope": newRequest(t, map[string]string{"envelope": "dummy"}, nil),
+		"invalid score":    newRequest(t, map[string]string{"spam_score": "dummy"}, nil),
+		"invalid raw":      newRequest(t, map[string]string{"email": "dummy"}, nil),
+	}
+
+	for name, r := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse(r, DefaultMaxMemory); err == nil {
+				t.Fatal("expected an error but got none")
+			}
+		})
+	}
+}
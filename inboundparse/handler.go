@@ -0,0 +1,77 @@
+package inboundparse
+
+import (
+	"context"
+	"net/http"
+)
+
+const defaultMaxBodySize = 64 << 20
+
+// Handler is an http.Handler that decodes inbound parse requests and passes
+// each Email to a callback. Temporary attachment files are removed once the
+// callback returns.
+//
+// A callback error results in a 500 response so that SendGrid retries the delivery.
+type Handler struct {
+	callback    func(context.Context, *Email) error
+	maxMemory   int64
+	maxBodySize int64
+}
+
+// Option defines an option for a Handler
+type Option func(*Handler)
+
+// OptionMaxMemory - keep at most n bytes in memory, larger attachments are streamed to disk.
+func OptionMaxMemory(n int64) func(*Handler) {
+	return func(h *Handler) {
+		h.maxMemory = n
+	}
+}
+
+// OptionMaxBodySize - limit the size of accepted request bodies. Defaults to 64MB.
+func OptionMaxBodySize(n int64) func(*Handler) {
+	return func(h *Handler) {
+		h.maxBodySize = n
+	}
+}
+
+// NewHandler builds a Handler calling fn for every received email.
+func NewHandler(fn func(ctx context.Context, e *Email) error, options ...Option) *Handler {
+	h := &Handler{
+		callback:    fn,
+		maxMemory:   DefaultMaxMemory,
+		maxBodySize: defaultMaxBodySize,
+	}
+
+	for _, opt := range options {
+		opt(h)
+	}
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBodySize)
+
+	e, err := Parse(r, h.maxMemory)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer func() {
+		_ = e.Close()
+	}()
+
+	if err := h.callback(r.Context(), e); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,65 @@
+package inboundparse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	var subject string
+	h := NewHandler(func(ctx context.Context, e *Email) error {
+		subject = e.Subject
+		return nil
+	}, OptionMaxMemory(1024))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newRequest(t, map[string]string{"subject": "hello"}, map[string]string{"attachment1": "content"}))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "hello", subject)
+}
+
+func TestHandler_Failed(t *testing.T) {
+	ok := func(ctx context.Context, e *Email) error { return nil }
+
+	tests := []struct {
+		name     string
+		handler  *Handler
+		request  *http.Request
+		expected int
+	}{
+		{
+			name:     "method not allowed",
+			handler:  NewHandler(ok),
+			request:  httptest.NewRequest(http.MethodGet, "/inbound", nil),
+			expected: http.StatusMethodNotAllowed,
+		},
+		{
+			name:     "body too large",
+			handler:  NewHandler(ok, OptionMaxBodySize(10)),
+			request:  newRequest(t, map[string]string{"subject": "hello"}, nil),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name: "callback failed",
+			handler: NewHandler(func(ctx context.Context, e *Email) error {
+				return errors.New("failed")
+			}),
+			request:  newRequest(t, map[string]string{"subject": "hello"}, nil),
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.handler.ServeHTTP(w, tt.request)
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
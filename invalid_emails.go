@@ -0,0 +1,134 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+type InvalidEmail struct {
+	Created int64  `json:"created,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+type InputGetInvalidEmails struct {
+	StartTime int64
+	EndTime   int64
+	Limit     int
+	Offset    int
+	Email     string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/invalid-e-mails-api/retrieve-all-invalid-emails
+func (c *Client) GetInvalidEmails(ctx context.Context, input *InputGetInvalidEmails) ([]*InvalidEmail, error) {
+	u, err := url.Parse("/suppression/invalid_emails")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.StartTime > 0 {
+		q.Set("start_time", strconv.FormatInt(input.StartTime, 10))
+	}
+	if input.EndTime > 0 {
+		q.Set("end_time", strconv.FormatInt(input.EndTime, 10))
+	}
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	if input.Email != "" {
+		q.Set("email", input.Email)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*InvalidEmail{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// AllInvalidEmails iterates over every entry, advancing offset by limit.
+func (c *Client) AllInvalidEmails(ctx context.Context, input *InputGetInvalidEmails) iter.Seq2[*InvalidEmail, error] {
+	in := InputGetInvalidEmails{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*InvalidEmail] {
+		in := in
+		return func(ctx context.Context) ([]*InvalidEmail, bool, error) {
+			r, err := c.GetInvalidEmails(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/invalid-e-mails-api/retrieve-a-specific-invalid-email
+func (c *Client) GetInvalidEmail(ctx context.Context, email string) ([]*InvalidEmail, error) {
+	path := fmt.Sprintf("/suppression/invalid_emails/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*InvalidEmail{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputDeleteInvalidEmails struct {
+	DeleteAll bool     `json:"delete_all,omitempty"`
+	Emails    []string `json:"emails,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/invalid-e-mails-api/delete-invalid-emails
+func (c *Client) DeleteInvalidEmails(ctx context.Context, input *InputDeleteInvalidEmails) error {
+	req, err := c.NewRequest("DELETE", "/suppression/invalid_emails", input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/invalid-e-mails-api/delete-a-specific-invalid-email
+func (c *Client) DeleteInvalidEmail(ctx context.Context, email string) error {
+	path := fmt.Sprintf("/suppression/invalid_emails/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+)
+
+func TestGetInvalidEmails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "end_time=1700003600&limit=10&start_time=1700000000" {
+			t.Errorf("query: %v", got)
+		}
+		if _, err := fmt.Fprint(w, `[
+			{"created": 1700000000, "email": "dummy@example.com", "reason": "dummy"}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetInvalidEmails(context.TODO(), &InputGetInvalidEmails{
+		StartTime: 1700000000,
+		EndTime:   1700003600,
+		Limit:     10,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*InvalidEmail{
+		{
+			Created: 1700000000,
+			Email:   "dummy@example.com",
+			Reason:  "dummy",
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetInvalidEmails_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetInvalidEmails(context.TODO(), &InputGetInvalidEmails{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAllInvalidEmails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails", func(w http.ResponseWriter, r *http.Request) {
+		body := `[{"email": "a@example.com"}, {"email": "b@example.com"}]`
+		if r.URL.Query().Get("offset") == "2" {
+			body = `[{"email": "c@example.com"}]`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	emails := []string{}
+	for v, err := range client.AllInvalidEmails(context.TODO(), &InputGetInvalidEmails{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		emails = append(emails, v.Email)
+	}
+
+	if want := []string{"a@example.com", "b@example.com", "c@example.com"}; !reflect.DeepEqual(want, emails) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, emails)))
+	}
+}
+
+func TestGetInvalidEmail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails/dummy@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[{"created": 1700000000, "email": "dummy@example.com"}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetInvalidEmail(context.TODO(), "dummy@example.com")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*InvalidEmail{{Created: 1700000000, Email: "dummy@example.com"}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetInvalidEmail_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails/dummy@example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetInvalidEmail(context.TODO(), "dummy@example.com")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestDeleteInvalidEmails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(body), "{\"delete_all\":true}\n"; got != want {
+			t.Errorf("body: %v, want %v", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteInvalidEmails(context.TODO(), &InputDeleteInvalidEmails{DeleteAll: true})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestDeleteInvalidEmails_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	err := client.DeleteInvalidEmails(context.TODO(), &InputDeleteInvalidEmails{Emails: []string{"dummy@example.com"}})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestDeleteInvalidEmail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails/dummy@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteInvalidEmail(context.TODO(), "dummy@example.com")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestDeleteInvalidEmail_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/invalid_emails/dummy@example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	err := client.DeleteInvalidEmail(context.TODO(), "dummy@example.com")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
@@ -0,0 +1,153 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type AllowedIP struct {
+	ID        int64  `json:"id,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	UpdatedAt int64  `json:"updated_at,omitempty"`
+}
+
+type OutputGetAllowedIPs struct {
+	Result []*AllowedIP `json:"result,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-access-management/retrieve-a-list-of-currently-allowed-ips
+func (c *Client) GetAllowedIPs(ctx context.Context) (*OutputGetAllowedIPs, error) {
+	req, err := c.NewRequest("GET", "/access_settings/whitelist", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetAllowedIPs)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputAddAllowedIPs struct {
+	IPs []*InputAllowedIP `json:"ips"`
+}
+
+type InputAllowedIP struct {
+	// IP is an IP address or a CIDR range, e.g. "192.0.2.0/24".
+	IP string `json:"ip"`
+}
+
+type OutputAddAllowedIPs struct {
+	Result []*AllowedIP `json:"result,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-access-management/add-one-or-more-ips-to-the-allow-list
+func (c *Client) AddAllowedIPs(ctx context.Context, input *InputAddAllowedIPs) (*OutputAddAllowedIPs, error) {
+	req, err := c.NewRequest("POST", "/access_settings/whitelist", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputAddAllowedIPs)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputDeleteAllowedIPs struct {
+	IDs []int64 `json:"ids"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-access-management/remove-one-or-more-ips-from-the-allow-list
+func (c *Client) DeleteAllowedIPs(ctx context.Context, input *InputDeleteAllowedIPs) error {
+	req, err := c.NewRequest("DELETE", "/access_settings/whitelist", input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-access-management/retrieve-a-specific-allowed-ip
+func (c *Client) GetAllowedIP(ctx context.Context, id int64) (*AllowedIP, error) {
+	path := fmt.Sprintf("/access_settings/whitelist/%s", strconv.FormatInt(id, 10))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(AllowedIP)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-access-management/remove-a-specific-ip-from-the-allowed-list
+func (c *Client) DeleteAllowedIP(ctx context.Context, id int64) error {
+	path := fmt.Sprintf("/access_settings/whitelist/%s", strconv.FormatInt(id, 10))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+type AccessAttempt struct {
+	Allowed    bool   `json:"allowed,omitempty"`
+	AuthMethod string `json:"auth_method,omitempty"`
+	FirstAt    int64  `json:"first_at,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	LastAt     int64  `json:"last_at,omitempty"`
+	Location   string `json:"location,omitempty"`
+}
+
+type InputGetAccessActivity struct {
+	// Limit is the number of attempts to return, up to 20.
+	Limit int
+}
+
+type OutputGetAccessActivity struct {
+	Result []*AccessAttempt `json:"result,omitempty"`
+}
+
+// GetAccessActivity returns the most recent attempts to access the account,
+// including the ones denied by the allow list.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-access-management/retrieve-all-recent-access-attempts
+func (c *Client) GetAccessActivity(ctx context.Context, input *InputGetAccessActivity) (*OutputGetAccessActivity, error) {
+	u, err := url.Parse("/access_settings/activity")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetAccessActivity)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
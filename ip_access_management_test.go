@@ -0,0 +1,190 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllowedIPs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/whitelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"result": [{"id": 1, "ip": "192.0.2.0/24", "created_at": 1700000000, "updated_at": 1700000001}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAllowedIPs(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetAllowedIPs{
+		Result: []*AllowedIP{{ID: 1, IP: "192.0.2.0/24", CreatedAt: 1700000000, UpdatedAt: 1700000001}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetAllowedIPs_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/whitelist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.GetAllowedIPs(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAddAllowedIPs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/whitelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"ips": [{"ip": "192.0.2.1"}, {"ip": "198.51.100.0/24"}]}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{"result": [{"id": 1, "ip": "192.0.2.1"}, {"id": 2, "ip": "198.51.100.0/24"}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.AddAllowedIPs(context.TODO(), &InputAddAllowedIPs{
+		IPs: []*InputAllowedIP{{IP: "192.0.2.1"}, {IP: "198.51.100.0/24"}},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputAddAllowedIPs{
+		Result: []*AllowedIP{{ID: 1, IP: "192.0.2.1"}, {ID: 2, IP: "198.51.100.0/24"}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteAllowedIPs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/whitelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"ids": [1, 2]}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteAllowedIPs(context.TODO(), &InputDeleteAllowedIPs{IDs: []int64{1, 2}}); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestGetAllowedIP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/whitelist/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"id": 1, "ip": "192.0.2.1", "created_at": 1700000000, "updated_at": 1700000000}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAllowedIP(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &AllowedIP{ID: 1, IP: "192.0.2.1", CreatedAt: 1700000000, UpdatedAt: 1700000000}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteAllowedIP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/whitelist/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteAllowedIP(context.TODO(), 1); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestDeleteAllowedIP_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/whitelist/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if err := client.DeleteAllowedIP(context.TODO(), 1); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetAccessActivity(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/access_settings/activity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "limit=5", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `{"result": [{
+			"allowed": false,
+			"auth_method": "basic",
+			"first_at": 1700000000,
+			"ip": "203.0.113.7",
+			"last_at": 1700000100,
+			"location": "Australia"
+		}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAccessActivity(context.TODO(), &InputGetAccessActivity{Limit: 5})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetAccessActivity{
+		Result: []*AccessAttempt{
+			{AuthMethod: "basic", FirstAt: 1700000000, IP: "203.0.113.7", LastAt: 1700000100, Location: "Australia"},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
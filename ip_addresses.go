@@ -0,0 +1,176 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+type IPAddress struct {
+	IP           string   `json:"ip,omitempty"`
+	Subusers     []string `json:"subusers,omitempty"`
+	RDNS         string   `json:"rdns,omitempty"`
+	Pools        []string `json:"pools,omitempty"`
+	Warmup       bool     `json:"warmup,omitempty"`
+	StartDate    int64    `json:"start_date,omitempty"`
+	Whitelabeled bool     `json:"whitelabeled,omitempty"`
+	AssignedAt   int64    `json:"assigned_at,omitempty"`
+}
+
+type InputGetIPAddresses struct {
+	IP                 string
+	ExcludeWhitelabels bool
+	Subuser            string
+	// SortByDirection is "asc" or "desc" (default).
+	SortByDirection string
+	Limit           int
+	Offset          int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-address/retrieve-all-ip-addresses
+func (c *Client) GetIPAddresses(ctx context.Context, input *InputGetIPAddresses) ([]*IPAddress, error) {
+	u, err := url.Parse("/ips")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.IP != "" {
+		q.Set("ip", input.IP)
+	}
+	if input.ExcludeWhitelabels {
+		q.Set("exclude_whitelabels", "true")
+	}
+	if input.Subuser != "" {
+		q.Set("subuser", input.Subuser)
+	}
+	if input.SortByDirection != "" {
+		q.Set("sort_by_direction", input.SortByDirection)
+	}
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*IPAddress{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// AllIPAddresses iterates over every IP address, advancing offset by limit.
+func (c *Client) AllIPAddresses(ctx context.Context, input *InputGetIPAddresses) iter.Seq2[*IPAddress, error] {
+	in := InputGetIPAddresses{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*IPAddress] {
+		in := in
+		return func(ctx context.Context) ([]*IPAddress, bool, error) {
+			r, err := c.GetIPAddresses(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-address/retrieve-all-assigned-ips
+func (c *Client) GetAssignedIPAddresses(ctx context.Context) ([]*IPAddress, error) {
+	req, err := c.NewRequest("GET", "/ips/assigned", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*IPAddress{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetIPAddress returns an IP address of the account, e.g. to make sure it
+// exists before assigning it to a subuser, an authenticated domain or a
+// reverse DNS record.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-address/retrieve-all-ip-pools-an-ip-address-belongs-to
+func (c *Client) GetIPAddress(ctx context.Context, ip string) (*IPAddress, error) {
+	path := fmt.Sprintf("/ips/%s", ip)
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(IPAddress)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputAddIPAddresses struct {
+	Count    int64    `json:"count"`
+	Subusers []string `json:"subusers,omitempty"`
+	Warmup   bool     `json:"warmup"`
+}
+
+type OutputAddIPAddresses struct {
+	IPs          []*IPAddress `json:"ips,omitempty"`
+	RemainingIPs int64        `json:"remaining_ips,omitempty"`
+	Warmup       bool         `json:"warmup,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-address/add-ips
+func (c *Client) AddIPAddresses(ctx context.Context, input *InputAddIPAddresses) (*OutputAddIPAddresses, error) {
+	req, err := c.NewRequest("POST", "/ips", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputAddIPAddresses)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type RemainingIPAddresses struct {
+	Remaining  int64   `json:"remaining,omitempty"`
+	Period     string  `json:"period,omitempty"`
+	PricePerIP float64 `json:"price_per_ip,omitempty"`
+}
+
+type OutputGetRemainingIPAddresses struct {
+	Results []*RemainingIPAddresses `json:"results,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-address/get-remaining-ips-count
+func (c *Client) GetRemainingIPAddresses(ctx context.Context) (*OutputGetRemainingIPAddresses, error) {
+	req, err := c.NewRequest("GET", "/ips/remaining", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetRemainingIPAddresses)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
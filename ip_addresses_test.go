@@ -0,0 +1,231 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIPAddresses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "exclude_whitelabels=true&limit=10&subuser=sub1", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `[{
+			"ip": "192.0.2.1",
+			"subusers": ["sub1"],
+			"rdns": "o1.email.example.com",
+			"pools": ["transactional"],
+			"warmup": true,
+			"start_date": 1700000000,
+			"whitelabeled": true,
+			"assigned_at": 1690000000
+		}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetIPAddresses(context.TODO(), &InputGetIPAddresses{
+		ExcludeWhitelabels: true,
+		Subuser:            "sub1",
+		Limit:              10,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*IPAddress{
+		{
+			IP:           "192.0.2.1",
+			Subusers:     []string{"sub1"},
+			RDNS:         "o1.email.example.com",
+			Pools:        []string{"transactional"},
+			Warmup:       true,
+			StartDate:    1700000000,
+			Whitelabeled: true,
+			AssignedAt:   1690000000,
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetIPAddresses_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.GetIPAddresses(context.TODO(), &InputGetIPAddresses{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAllIPAddresses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		switch offset {
+		case 0:
+			fmt.Fprint(w, `[{"ip": "192.0.2.1"}]`)
+		case 1:
+			fmt.Fprint(w, `[{"ip": "192.0.2.2"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	var ips []string
+	for ip, err := range client.AllIPAddresses(context.TODO(), &InputGetIPAddresses{Limit: 1}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ips = append(ips, ip.IP)
+	}
+	assert.Equal(t, []string{"192.0.2.1", "192.0.2.2"}, ips)
+}
+
+func TestGetAssignedIPAddresses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/assigned", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[{"ip": "192.0.2.1", "pools": ["marketing"], "warmup": false, "start_date": 0}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAssignedIPAddresses(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*IPAddress{{IP: "192.0.2.1", Pools: []string{"marketing"}}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetIPAddress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/192.0.2.1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"ip": "192.0.2.1", "subusers": ["sub1"], "pools": [], "warmup": false}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetIPAddress(context.TODO(), "192.0.2.1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &IPAddress{IP: "192.0.2.1", Subusers: []string{"sub1"}, Pools: []string{}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetIPAddress_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/192.0.2.9", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetIPAddress(context.TODO(), "192.0.2.9")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAddIPAddresses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"count": 1, "subusers": ["sub1"], "warmup": true}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{
+			"ips": [{"ip": "192.0.2.3", "subusers": ["sub1"]}],
+			"remaining_ips": 2,
+			"warmup": true
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.AddIPAddresses(context.TODO(), &InputAddIPAddresses{
+		Count:    1,
+		Subusers: []string{"sub1"},
+		Warmup:   true,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputAddIPAddresses{
+		IPs:          []*IPAddress{{IP: "192.0.2.3", Subusers: []string{"sub1"}}},
+		RemainingIPs: 2,
+		Warmup:       true,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetRemainingIPAddresses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/remaining", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"results": [{"remaining": 2, "period": "month", "price_per_ip": 20}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetRemainingIPAddresses(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetRemainingIPAddresses{
+		Results: []*RemainingIPAddresses{{Remaining: 2, Period: "month", PricePerIP: 20}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
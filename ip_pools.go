@@ -0,0 +1,141 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type IPPool struct {
+	Name string `json:"name,omitempty"`
+}
+
+type IPPoolIP struct {
+	IP        string   `json:"ip,omitempty"`
+	Pools     []string `json:"pools,omitempty"`
+	StartDate int64    `json:"start_date,omitempty"`
+	Warmup    bool     `json:"warmup,omitempty"`
+}
+
+type InputCreateIPPool struct {
+	Name string `json:"name"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/create-an-ip-pool
+func (c *Client) CreateIPPool(ctx context.Context, input *InputCreateIPPool) (*IPPool, error) {
+	req, err := c.NewRequest("POST", "/ips/pools", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(IPPool)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/retrieve-all-ip-pools
+func (c *Client) GetIPPools(ctx context.Context) ([]*IPPool, error) {
+	req, err := c.NewRequest("GET", "/ips/pools", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*IPPool{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputGetIPPool struct {
+	PoolName string      `json:"pool_name,omitempty"`
+	IPs      []*IPPoolIP `json:"ips,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/retrieve-all-the-ips-in-a-specified-pool
+func (c *Client) GetIPPool(ctx context.Context, name string) (*OutputGetIPPool, error) {
+	path := fmt.Sprintf("/ips/pools/%s", url.PathEscape(name))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetIPPool)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateIPPool struct {
+	Name string `json:"name"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/rename-an-ip-pool
+func (c *Client) UpdateIPPool(ctx context.Context, name string, input *InputUpdateIPPool) (*IPPool, error) {
+	path := fmt.Sprintf("/ips/pools/%s", url.PathEscape(name))
+
+	req, err := c.NewRequest("PUT", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(IPPool)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/delete-an-ip-pool
+func (c *Client) DeleteIPPool(ctx context.Context, name string) error {
+	path := fmt.Sprintf("/ips/pools/%s", url.PathEscape(name))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InputAddIPToIPPool struct {
+	IP string `json:"ip"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/add-an-ip-address-to-a-pool
+func (c *Client) AddIPToIPPool(ctx context.Context, name string, input *InputAddIPToIPPool) (*IPPoolIP, error) {
+	path := fmt.Sprintf("/ips/pools/%s/ips", url.PathEscape(name))
+
+	req, err := c.NewRequest("POST", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(IPPoolIP)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-pools/remove-an-ip-address-from-a-pool
+func (c *Client) RemoveIPFromIPPool(ctx context.Context, name, ip string) error {
+	path := fmt.Sprintf("/ips/pools/%s/ips/%s", url.PathEscape(name), ip)
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
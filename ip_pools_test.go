@@ -0,0 +1,203 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateIPPool(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "marketing"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"name": "marketing"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.CreateIPPool(context.TODO(), &InputCreateIPPool{Name: "marketing"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &IPPool{Name: "marketing"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestCreateIPPool_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.CreateIPPool(context.TODO(), &InputCreateIPPool{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetIPPools(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[{"name": "marketing"}, {"name": "transactional"}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetIPPools(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*IPPool{{Name: "marketing"}, {Name: "transactional"}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetIPPool(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools/marketing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"pool_name": "marketing", "ips": [{"ip": "192.0.2.1", "start_date": 1700000000, "warmup": true}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetIPPool(context.TODO(), "marketing")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetIPPool{
+		PoolName: "marketing",
+		IPs:      []*IPPoolIP{{IP: "192.0.2.1", StartDate: 1700000000, Warmup: true}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateIPPool(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools/marketing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "newsletters"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"name": "newsletters"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateIPPool(context.TODO(), "marketing", &InputUpdateIPPool{Name: "newsletters"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &IPPool{Name: "newsletters"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteIPPool(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools/marketing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteIPPool(context.TODO(), "marketing"); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestAddIPToIPPool(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools/marketing/ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"ip": "192.0.2.1"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{"ip": "192.0.2.1", "pools": ["marketing"], "start_date": 1700000000, "warmup": true}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.AddIPToIPPool(context.TODO(), "marketing", &InputAddIPToIPPool{IP: "192.0.2.1"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &IPPoolIP{IP: "192.0.2.1", Pools: []string{"marketing"}, StartDate: 1700000000, Warmup: true}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestRemoveIPFromIPPool(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools/marketing/ips/192.0.2.1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.RemoveIPFromIPPool(context.TODO(), "marketing", "192.0.2.1"); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestRemoveIPFromIPPool_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/pools/marketing/ips/192.0.2.1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if err := client.RemoveIPFromIPPool(context.TODO(), "marketing", "192.0.2.1"); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
@@ -0,0 +1,74 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+)
+
+type WarmupIP struct {
+	IP        string `json:"ip,omitempty"`
+	StartDate int64  `json:"start_date,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-warmup/retrieve-all-ips-currently-in-warmup
+func (c *Client) GetWarmupIPs(ctx context.Context) ([]*WarmupIP, error) {
+	req, err := c.NewRequest("GET", "/ips/warmup", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*WarmupIP{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-warmup/retrieve-the-warmup-status-for-a-specific-ip-address
+func (c *Client) GetWarmupIP(ctx context.Context, ip string) ([]*WarmupIP, error) {
+	path := fmt.Sprintf("/ips/warmup/%s", ip)
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*WarmupIP{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputStartIPWarmup struct {
+	IP string `json:"ip"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-warmup/start-warming-up-an-ip-address
+func (c *Client) StartIPWarmup(ctx context.Context, input *InputStartIPWarmup) ([]*WarmupIP, error) {
+	req, err := c.NewRequest("POST", "/ips/warmup", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*WarmupIP{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/ip-warmup/stop-warming-up-an-ip-address
+func (c *Client) StopIPWarmup(ctx context.Context, ip string) error {
+	path := fmt.Sprintf("/ips/warmup/%s", ip)
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
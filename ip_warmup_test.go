@@ -0,0 +1,116 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWarmupIPs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/warmup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[{"ip": "192.0.2.1", "start_date": 1700000000}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetWarmupIPs(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*WarmupIP{{IP: "192.0.2.1", StartDate: 1700000000}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetWarmupIP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/warmup/192.0.2.1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[{"ip": "192.0.2.1", "start_date": 1700000000}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetWarmupIP(context.TODO(), "192.0.2.1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*WarmupIP{{IP: "192.0.2.1", StartDate: 1700000000}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestStartIPWarmup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/warmup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"ip": "192.0.2.1"}`, string(body))
+		if _, err := fmt.Fprint(w, `[{"ip": "192.0.2.1", "start_date": 1700000000}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.StartIPWarmup(context.TODO(), &InputStartIPWarmup{IP: "192.0.2.1"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*WarmupIP{{IP: "192.0.2.1", StartDate: 1700000000}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestStartIPWarmup_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/warmup", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.StartIPWarmup(context.TODO(), &InputStartIPWarmup{IP: "192.0.2.1"})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestStopIPWarmup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips/warmup/192.0.2.1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.StopIPWarmup(context.TODO(), "192.0.2.1"); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
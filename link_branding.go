@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -103,6 +104,23 @@ func (c *Client) GetBrandedLinks(ctx context.Context, input *InputGetBrandedLink
 	return r, nil
 }
 
+// AllBrandedLinks iterates over branded links. The endpoint has no offset or
+// cursor, so only the first page is fetched: raise Limit to get more links.
+func (c *Client) AllBrandedLinks(ctx context.Context, input *InputGetBrandedLinks) iter.Seq2[*BrandedLink, error] {
+	in := InputGetBrandedLinks{}
+	if input != nil {
+		in = *input
+	}
+
+	return paginate(ctx, func() pageFetcher[*BrandedLink] {
+		in := in
+		return func(ctx context.Context) ([]*BrandedLink, bool, error) {
+			r, err := c.GetBrandedLinks(ctx, &in)
+			return r, false, err
+		}
+	})
+}
+
 type OutputGetSubuserBrandedLink struct {
 	ID        int64          `json:"id,omitempty"`
 	Domain    string         `json:"domain,omitempty"`
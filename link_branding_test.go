@@ -630,3 +630,27 @@ func TestDeleteBrandedLink_Failed(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestAllBrandedLinks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/whitelabel/links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[{"id":1},{"id":2}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []int64{}
+	for link, err := range client.AllBrandedLinks(context.TODO(), &InputGetBrandedLinks{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, link.ID)
+	}
+
+	if want := []int64{1, 2}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+	}
+}
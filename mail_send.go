@@ -0,0 +1,318 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// documented limits of the v3 Mail Send API
+const (
+	MaxMailPersonalizations = 1000
+	MaxMailRecipients       = 1000
+	MaxMailCategories       = 10
+	MaxMailCategoryLength   = 255
+	MaxMailCustomArgsSize   = 10000
+	MaxMailSize             = 30 * 1024 * 1024
+)
+
+type MailAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type Personalization struct {
+	To                  []*MailAddress         `json:"to"`
+	Cc                  []*MailAddress         `json:"cc,omitempty"`
+	Bcc                 []*MailAddress         `json:"bcc,omitempty"`
+	From                *MailAddress           `json:"from,omitempty"`
+	Subject             string                 `json:"subject,omitempty"`
+	Headers             map[string]string      `json:"headers,omitempty"`
+	Substitutions       map[string]string      `json:"substitutions,omitempty"`
+	DynamicTemplateData map[string]interface{} `json:"dynamic_template_data,omitempty"`
+	CustomArgs          map[string]string      `json:"custom_args,omitempty"`
+	SendAt              int64                  `json:"send_at,omitempty"`
+}
+
+// AddTos appends recipients to the personalization.
+func (p *Personalization) AddTos(to ...*MailAddress) *Personalization {
+	p.To = append(p.To, to...)
+	return p
+}
+
+// SetDynamicTemplateData sets a value used by the handlebars of a dynamic template.
+func (p *Personalization) SetDynamicTemplateData(key string, value interface{}) *Personalization {
+	if p.DynamicTemplateData == nil {
+		p.DynamicTemplateData = map[string]interface{}{}
+	}
+	p.DynamicTemplateData[key] = value
+	return p
+}
+
+type MailContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type MailAttachment struct {
+	Content     string `json:"content"`
+	Type        string `json:"type,omitempty"`
+	Filename    string `json:"filename"`
+	Disposition string `json:"disposition,omitempty"`
+	ContentID   string `json:"content_id,omitempty"`
+}
+
+// NewMailAttachment builds an attachment from raw content, base64 encoding it.
+func NewMailAttachment(filename, contentType string, content []byte) *MailAttachment {
+	return &MailAttachment{
+		Content:  base64.StdEncoding.EncodeToString(content),
+		Type:     contentType,
+		Filename: filename,
+	}
+}
+
+type ASM struct {
+	GroupID         int64   `json:"group_id"`
+	GroupsToDisplay []int64 `json:"groups_to_display,omitempty"`
+}
+
+type MailSettings struct {
+	BypassListManagement        *MailSetting       `json:"bypass_list_management,omitempty"`
+	BypassSpamManagement        *MailSetting       `json:"bypass_spam_management,omitempty"`
+	BypassBounceManagement      *MailSetting       `json:"bypass_bounce_management,omitempty"`
+	BypassUnsubscribeManagement *MailSetting       `json:"bypass_unsubscribe_management,omitempty"`
+	Footer                      *FooterMailSetting `json:"footer,omitempty"`
+	SandboxMode                 *MailSetting       `json:"sandbox_mode,omitempty"`
+}
+
+type MailSetting struct {
+	Enable bool `json:"enable"`
+}
+
+type FooterMailSetting struct {
+	Enable bool   `json:"enable"`
+	Text   string `json:"text,omitempty"`
+	HTML   string `json:"html,omitempty"`
+}
+
+type MailTrackingSettings struct {
+	ClickTracking        *ClickTrackingSetting        `json:"click_tracking,omitempty"`
+	OpenTracking         *OpenTrackingSetting         `json:"open_tracking,omitempty"`
+	SubscriptionTracking *SubscriptionTrackingSetting `json:"subscription_tracking,omitempty"`
+	GoogleAnalytics      *GoogleAnalyticsSetting      `json:"ganalytics,omitempty"`
+}
+
+type ClickTrackingSetting struct {
+	Enable     bool `json:"enable"`
+	EnableText bool `json:"enable_text"`
+}
+
+type OpenTrackingSetting struct {
+	Enable          bool   `json:"enable"`
+	SubstitutionTag string `json:"substitution_tag,omitempty"`
+}
+
+type SubscriptionTrackingSetting struct {
+	Enable          bool   `json:"enable"`
+	Text            string `json:"text,omitempty"`
+	HTML            string `json:"html,omitempty"`
+	SubstitutionTag string `json:"substitution_tag,omitempty"`
+}
+
+type GoogleAnalyticsSetting struct {
+	Enable      bool   `json:"enable"`
+	UTMSource   string `json:"utm_source,omitempty"`
+	UTMMedium   string `json:"utm_medium,omitempty"`
+	UTMTerm     string `json:"utm_term,omitempty"`
+	UTMContent  string `json:"utm_content,omitempty"`
+	UTMCampaign string `json:"utm_campaign,omitempty"`
+}
+
+type MailMessage struct {
+	Personalizations []*Personalization    `json:"personalizations"`
+	From             *MailAddress          `json:"from"`
+	ReplyTo          *MailAddress          `json:"reply_to,omitempty"`
+	ReplyToList      []*MailAddress        `json:"reply_to_list,omitempty"`
+	Subject          string                `json:"subject,omitempty"`
+	Content          []*MailContent        `json:"content,omitempty"`
+	Attachments      []*MailAttachment     `json:"attachments,omitempty"`
+	TemplateID       string                `json:"template_id,omitempty"`
+	Headers          map[string]string     `json:"headers,omitempty"`
+	Categories       []string              `json:"categories,omitempty"`
+	CustomArgs       map[string]string     `json:"custom_args,omitempty"`
+	SendAt           int64                 `json:"send_at,omitempty"`
+	BatchID          string                `json:"batch_id,omitempty"`
+	ASM              *ASM                  `json:"asm,omitempty"`
+	IPPoolName       string                `json:"ip_pool_name,omitempty"`
+	MailSettings     *MailSettings         `json:"mail_settings,omitempty"`
+	TrackingSettings *MailTrackingSettings `json:"tracking_settings,omitempty"`
+}
+
+// NewMailMessage returns a message sent from the given address.
+func NewMailMessage(from *MailAddress, subject string) *MailMessage {
+	return &MailMessage{
+		From:    from,
+		Subject: subject,
+	}
+}
+
+// AddPersonalizations appends personalizations to the message.
+func (m *MailMessage) AddPersonalizations(p ...*Personalization) *MailMessage {
+	m.Personalizations = append(m.Personalizations, p...)
+	return m
+}
+
+// AddContent appends a body part, e.g. "text/plain" or "text/html".
+func (m *MailMessage) AddContent(contentType, value string) *MailMessage {
+	m.Content = append(m.Content, &MailContent{Type: contentType, Value: value})
+	return m
+}
+
+// AddAttachments appends attachments to the message.
+func (m *MailMessage) AddAttachments(a ...*MailAttachment) *MailMessage {
+	m.Attachments = append(m.Attachments, a...)
+	return m
+}
+
+// AddCategories appends categories to the message.
+func (m *MailMessage) AddCategories(categories ...string) *MailMessage {
+	m.Categories = append(m.Categories, categories...)
+	return m
+}
+
+// SetTemplate renders the message with the active version of t.
+func (m *MailMessage) SetTemplate(t *Template) *MailMessage {
+	m.TemplateID = t.ID
+	return m
+}
+
+// SetSuppressionGroup sets the unsubscribe group of the message and the
+// groups shown on the unsubscribe preferences page.
+func (m *MailMessage) SetSuppressionGroup(group *SuppressionGroup, groupsToDisplay ...*SuppressionGroup) *MailMessage {
+	m.ASM = &ASM{GroupID: group.ID}
+	for _, g := range groupsToDisplay {
+		m.ASM.GroupsToDisplay = append(m.ASM.GroupsToDisplay, g.ID)
+	}
+	return m
+}
+
+// SetSendAt schedules the message.
+func (m *MailMessage) SetSendAt(t time.Time) *MailMessage {
+	m.SendAt = t.Unix()
+	return m
+}
+
+// Validate checks the message against the documented limits of the Mail Send API.
+func (m *MailMessage) Validate() error {
+	if m.From == nil || m.From.Email == "" {
+		return errors.New("from email is required")
+	}
+
+	if len(m.Personalizations) == 0 {
+		return errors.New("at least one personalization is required")
+	}
+	if len(m.Personalizations) > MaxMailPersonalizations {
+		return errors.Errorf("personalizations must be %d or fewer, but got %d", MaxMailPersonalizations, len(m.Personalizations))
+	}
+
+	recipients := 0
+	for i, p := range m.Personalizations {
+		if len(p.To) == 0 {
+			return errors.Errorf("personalizations[%d] requires at least one to address", i)
+		}
+		recipients += len(p.To) + len(p.Cc) + len(p.Bcc)
+	}
+	if recipients > MaxMailRecipients {
+		return errors.Errorf("recipients must be %d or fewer, but got %d", MaxMailRecipients, recipients)
+	}
+
+	if len(m.Content) == 0 && m.TemplateID == "" {
+		return errors.New("content or template_id is required")
+	}
+
+	// a template provides the subject, otherwise the message or every
+	// personalization must have one
+	if m.TemplateID == "" && m.Subject == "" {
+		for i, p := range m.Personalizations {
+			if p.Subject == "" {
+				return errors.Errorf("personalizations[%d] requires a subject when there is no message subject or template_id", i)
+			}
+		}
+	}
+
+	for i, a := range m.Attachments {
+		if a.Content == "" {
+			return errors.Errorf("attachments[%d] requires content", i)
+		}
+		if a.Filename == "" {
+			return errors.Errorf("attachments[%d] requires a filename", i)
+		}
+	}
+
+	if len(m.Categories) > MaxMailCategories {
+		return errors.Errorf("categories must be %d or fewer, but got %d", MaxMailCategories, len(m.Categories))
+	}
+	for _, category := range m.Categories {
+		if len(category) > MaxMailCategoryLength {
+			return errors.Errorf("category %q must be %d characters or fewer", category, MaxMailCategoryLength)
+		}
+	}
+
+	if len(m.CustomArgs) > 0 {
+		b, err := json.Marshal(m.CustomArgs)
+		if err != nil {
+			return err
+		}
+		if len(b) > MaxMailCustomArgsSize {
+			return errors.Errorf("custom_args must be %d bytes or fewer, but got %d", MaxMailCustomArgsSize, len(b))
+		}
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if len(b) > MaxMailSize {
+		return errors.Errorf("message must be %d bytes or fewer, but got %d", MaxMailSize, len(b))
+	}
+
+	return nil
+}
+
+type OutputSendMail struct {
+	// MessageID is the X-Message-Id header, which identifies the message in
+	// the Email Activity and event webhook payloads.
+	MessageID string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/mail-send/mail-send
+func (c *Client) SendMail(ctx context.Context, input *MailMessage) (*OutputSendMail, error) {
+	if ctx == nil {
+		return nil, errors.New("context must be non-nil")
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("POST", "/mail/send", input)
+	if err != nil {
+		return nil, err
+	}
+
+	// reuse the Response registered by the caller, if any, so it is still filled
+	resp, ok := ctx.Value(responseKey{}).(*Response)
+	if !ok || resp == nil {
+		resp = new(Response)
+		ctx = WithResponse(ctx, resp)
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return nil, err
+	}
+	return &OutputSendMail{
+		MessageID: resp.Header.Get("X-Message-Id"),
+	}, nil
+}
@@ -0,0 +1,185 @@
+package sendgrid
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendMail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail/send", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{
+			"personalizations": [
+				{
+					"to": [{"email": "to@example.com", "name": "To"}],
+					"dynamic_template_data": {"name": "dummy"}
+				}
+			],
+			"from": {"email": "from@example.com"},
+			"subject": "subject",
+			"attachments": [{"content": "aGVsbG8=", "type": "text/plain", "filename": "hello.txt"}],
+			"template_id": "d-12345",
+			"categories": ["welcome"],
+			"send_at": 1700000000,
+			"asm": {"group_id": 1, "groups_to_display": [1, 2]},
+			"mail_settings": {"sandbox_mode": {"enable": true}},
+			"tracking_settings": {"click_tracking": {"enable": false, "enable_text": false}}
+		}`, string(body))
+		w.Header().Set("X-Message-Id", "dummy-message-id")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	p := (&Personalization{}).
+		AddTos(&MailAddress{Email: "to@example.com", Name: "To"}).
+		SetDynamicTemplateData("name", "dummy")
+
+	m := NewMailMessage(&MailAddress{Email: "from@example.com"}, "subject").
+		AddPersonalizations(p).
+		AddAttachments(NewMailAttachment("hello.txt", "text/plain", []byte("hello"))).
+		AddCategories("welcome").
+		SetTemplate(&Template{ID: "d-12345"}).
+		SetSuppressionGroup(&SuppressionGroup{ID: 1}, &SuppressionGroup{ID: 1}, &SuppressionGroup{ID: 2}).
+		SetSendAt(time.Unix(1700000000, 0))
+	m.MailSettings = &MailSettings{SandboxMode: &MailSetting{Enable: true}}
+	m.TrackingSettings = &MailTrackingSettings{ClickTracking: &ClickTrackingSetting{}}
+
+	expected, err := client.SendMail(context.TODO(), m)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := &OutputSendMail{MessageID: "dummy-message-id"}
+	assert.Equal(t, want, expected)
+}
+
+func TestSendMail_NilContext(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	m := NewMailMessage(&MailAddress{Email: "from@example.com"}, "subject").
+		AddPersonalizations((&Personalization{}).AddTos(&MailAddress{Email: "to@example.com"})).
+		AddContent("text/plain", "hello")
+
+	//nolint:staticcheck // a nil context must return an error, not panic
+	_, err := client.SendMail(nil, m)
+	assert.Error(t, err)
+}
+
+func TestSendMail_WithResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail/send", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Message-Id", "dummy-message-id")
+		w.Header().Set("X-Request-Id", "dummy-request-id")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	m := NewMailMessage(&MailAddress{Email: "from@example.com"}, "subject").
+		AddPersonalizations((&Personalization{}).AddTos(&MailAddress{Email: "to@example.com"})).
+		AddContent("text/plain", "hello")
+
+	var resp Response
+	expected, err := client.SendMail(WithResponse(context.TODO(), &resp), m)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Equal(t, "dummy-message-id", expected.MessageID)
+	assert.Equal(t, "dummy-request-id", resp.RequestID)
+}
+
+func TestSendMail_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail/send", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	m := NewMailMessage(&MailAddress{Email: "from@example.com"}, "subject").
+		AddPersonalizations((&Personalization{}).AddTos(&MailAddress{Email: "to@example.com"})).
+		AddContent("text/plain", "hello")
+
+	if _, err := client.SendMail(context.TODO(), m); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestMailMessage_Validate(t *testing.T) {
+	valid := func() *MailMessage {
+		return NewMailMessage(&MailAddress{Email: "from@example.com"}, "subject").
+			AddPersonalizations((&Personalization{}).AddTos(&MailAddress{Email: "to@example.com"})).
+			AddContent("text/plain", "hello")
+	}
+
+	tests := []struct {
+		name   string
+		modify func(m *MailMessage)
+	}{
+		{"no from", func(m *MailMessage) { m.From = nil }},
+		{"no personalizations", func(m *MailMessage) { m.Personalizations = nil }},
+		{"no to", func(m *MailMessage) { m.Personalizations[0].To = nil }},
+		{"no content", func(m *MailMessage) { m.Content = nil }},
+		{"no subject", func(m *MailMessage) { m.Subject = "" }},
+		{"attachment without content", func(m *MailMessage) {
+			m.AddAttachments(&MailAttachment{Type: "text/plain", Filename: "hello.txt"})
+		}},
+		{"attachment without filename", func(m *MailMessage) {
+			m.AddAttachments(NewMailAttachment("", "text/plain", []byte("hello")))
+		}},
+		{"too many personalizations", func(m *MailMessage) {
+			for range MaxMailPersonalizations {
+				m.AddPersonalizations((&Personalization{}).AddTos(&MailAddress{Email: "to@example.com"}))
+			}
+		}},
+		{"too many recipients", func(m *MailMessage) {
+			for range MaxMailRecipients {
+				m.Personalizations[0].Bcc = append(m.Personalizations[0].Bcc, &MailAddress{Email: "bcc@example.com"})
+			}
+		}},
+		{"too many categories", func(m *MailMessage) {
+			m.AddCategories("1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11")
+		}},
+		{"category too long", func(m *MailMessage) { m.AddCategories(strings.Repeat("a", MaxMailCategoryLength+1)) }},
+		{"custom args too large", func(m *MailMessage) {
+			m.CustomArgs = map[string]string{"key": strings.Repeat("a", MaxMailCustomArgsSize)}
+		}},
+		{"message too large", func(m *MailMessage) {
+			m.AddAttachments(NewMailAttachment("large.bin", "application/octet-stream", make([]byte, MaxMailSize)))
+		}},
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	m := valid()
+	m.Subject = ""
+	m.Personalizations[0].Subject = "subject"
+	assert.NoError(t, m.Validate(), "a personalization subject is enough")
+
+	m = valid()
+	m.Subject = ""
+	m.TemplateID = "d-12345"
+	assert.NoError(t, m.Validate(), "a template provides the subject")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := valid()
+			tt.modify(m)
+			assert.Error(t, m.Validate())
+		})
+	}
+}
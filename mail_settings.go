@@ -0,0 +1,414 @@
+package sendgrid
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type InputGetMailSettings struct {
+	Limit  int
+	Offset int
+}
+
+type OutputGetMailSettings struct {
+	Result []*ResultGetMailSettings `json:"result,omitempty"`
+}
+
+type ResultGetMailSettings struct {
+	Name        string `json:"name,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-all-mail-settings
+func (c *Client) GetMailSettings(ctx context.Context, input *InputGetMailSettings) (*OutputGetMailSettings, error) {
+	u, err := url.Parse("/mail_settings")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetMailSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetAddressWhitelistSettings struct {
+	Enabled bool     `json:"enabled,omitempty"`
+	List    []string `json:"list,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-address-whitelist-mail-settings
+func (c *Client) GetAddressWhitelistSettings(ctx context.Context) (*OutputGetAddressWhitelistSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/address_whitelist", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetAddressWhitelistSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputUpdateAddressWhitelistSettings struct {
+	Enabled bool `json:"enabled"`
+	// List replaces the whole allowlist. A nil List is left unchanged;
+	// &[]string{} clears it.
+	List *[]string `json:"list,omitempty"`
+}
+
+type OutputUpdateAddressWhitelistSettings struct {
+	Enabled bool     `json:"enabled,omitempty"`
+	List    []string `json:"list,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-address-whitelist-mail-settings
+func (c *Client) UpdateAddressWhitelistSettings(ctx context.Context, input *InputUpdateAddressWhitelistSettings) (*OutputUpdateAddressWhitelistSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/address_whitelist", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateAddressWhitelistSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetBypassBounceManagementSettings struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-bypass-bounce-management
+func (c *Client) GetBypassBounceManagementSettings(ctx context.Context) (*OutputGetBypassBounceManagementSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/bypass_bounce_management", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetBypassBounceManagementSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputUpdateBypassBounceManagementSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+type OutputUpdateBypassBounceManagementSettings struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-bypass-bounce-management
+func (c *Client) UpdateBypassBounceManagementSettings(ctx context.Context, input *InputUpdateBypassBounceManagementSettings) (*OutputUpdateBypassBounceManagementSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/bypass_bounce_management", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateBypassBounceManagementSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetBypassSpamManagementSettings struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-bypass-spam-management
+func (c *Client) GetBypassSpamManagementSettings(ctx context.Context) (*OutputGetBypassSpamManagementSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/bypass_spam_management", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetBypassSpamManagementSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputUpdateBypassSpamManagementSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+type OutputUpdateBypassSpamManagementSettings struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-bypass-spam-management
+func (c *Client) UpdateBypassSpamManagementSettings(ctx context.Context, input *InputUpdateBypassSpamManagementSettings) (*OutputUpdateBypassSpamManagementSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/bypass_spam_management", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateBypassSpamManagementSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetBypassUnsubscribeManagementSettings struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-bypass-unsubscribe-management
+func (c *Client) GetBypassUnsubscribeManagementSettings(ctx context.Context) (*OutputGetBypassUnsubscribeManagementSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/bypass_unsubscribe_management", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetBypassUnsubscribeManagementSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputUpdateBypassUnsubscribeManagementSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+type OutputUpdateBypassUnsubscribeManagementSettings struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-bypass-unsubscribe-management
+func (c *Client) UpdateBypassUnsubscribeManagementSettings(ctx context.Context, input *InputUpdateBypassUnsubscribeManagementSettings) (*OutputUpdateBypassUnsubscribeManagementSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/bypass_unsubscribe_management", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateBypassUnsubscribeManagementSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetFooterSettings struct {
+	Enabled      bool   `json:"enabled,omitempty"`
+	HTMLContent  string `json:"html_content,omitempty"`
+	PlainContent string `json:"plain_content,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-footer-mail-settings
+func (c *Client) GetFooterSettings(ctx context.Context) (*OutputGetFooterSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/footer", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetFooterSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// InputUpdateFooterSettings leaves nil contents unchanged; String("") clears them.
+type InputUpdateFooterSettings struct {
+	Enabled      bool    `json:"enabled"`
+	HTMLContent  *string `json:"html_content,omitempty"`
+	PlainContent *string `json:"plain_content,omitempty"`
+}
+
+type OutputUpdateFooterSettings struct {
+	Enabled      bool   `json:"enabled,omitempty"`
+	HTMLContent  string `json:"html_content,omitempty"`
+	PlainContent string `json:"plain_content,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-footer-mail-settings
+func (c *Client) UpdateFooterSettings(ctx context.Context, input *InputUpdateFooterSettings) (*OutputUpdateFooterSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/footer", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateFooterSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetForwardBounceSettings struct {
+	Enabled bool   `json:"enabled,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-forward-bounce-mail-settings
+func (c *Client) GetForwardBounceSettings(ctx context.Context) (*OutputGetForwardBounceSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/forward_bounce", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetForwardBounceSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// InputUpdateForwardBounceSettings leaves a nil Email unchanged; String("") clears it.
+type InputUpdateForwardBounceSettings struct {
+	Enabled bool    `json:"enabled"`
+	Email   *string `json:"email,omitempty"`
+}
+
+type OutputUpdateForwardBounceSettings struct {
+	Enabled bool   `json:"enabled,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-forward-bounce-mail-settings
+func (c *Client) UpdateForwardBounceSettings(ctx context.Context, input *InputUpdateForwardBounceSettings) (*OutputUpdateForwardBounceSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/forward_bounce", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateForwardBounceSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetForwardSpamSettings struct {
+	Enabled bool   `json:"enabled,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-forward-spam-mail-settings
+func (c *Client) GetForwardSpamSettings(ctx context.Context) (*OutputGetForwardSpamSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/forward_spam", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetForwardSpamSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputUpdateForwardSpamSettings struct {
+	Enabled bool `json:"enabled"`
+	// Email is a comma separated list of addresses to forward spam reports to.
+	// A nil Email is left unchanged; String("") clears it.
+	Email *string `json:"email,omitempty"`
+}
+
+type OutputUpdateForwardSpamSettings struct {
+	Enabled bool   `json:"enabled,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-forward-spam-mail-settings
+func (c *Client) UpdateForwardSpamSettings(ctx context.Context, input *InputUpdateForwardSpamSettings) (*OutputUpdateForwardSpamSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/forward_spam", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateForwardSpamSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetLegacyTemplateSettings struct {
+	Enabled     bool   `json:"enabled,omitempty"`
+	HTMLContent string `json:"html_content,omitempty"`
+}
+
+// GetLegacyTemplateSettings returns the legacy email template wrapping the content of every email.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/retrieve-legacy-template-mail-settings
+func (c *Client) GetLegacyTemplateSettings(ctx context.Context) (*OutputGetLegacyTemplateSettings, error) {
+	req, err := c.NewRequest("GET", "/mail_settings/template", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetLegacyTemplateSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// InputUpdateLegacyTemplateSettings leaves a nil HTMLContent unchanged; String("") clears it.
+type InputUpdateLegacyTemplateSettings struct {
+	Enabled     bool    `json:"enabled"`
+	HTMLContent *string `json:"html_content,omitempty"`
+}
+
+type OutputUpdateLegacyTemplateSettings struct {
+	Enabled     bool   `json:"enabled,omitempty"`
+	HTMLContent string `json:"html_content,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-legacy-template-mail-settings
+func (c *Client) UpdateLegacyTemplateSettings(ctx context.Context, input *InputUpdateLegacyTemplateSettings) (*OutputUpdateLegacyTemplateSettings, error) {
+	req, err := c.NewRequest("PATCH", "/mail_settings/template", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateLegacyTemplateSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,771 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMailSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "limit=2", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `{
+			"result":[
+				{
+					"title":"Address Whitelist",
+					"enabled":true,
+					"name":"address_whitelist",
+					"description":"Address / domains that should not be blocked."
+				},
+				{
+					"title":"Footer",
+					"enabled":false,
+					"name":"footer",
+					"description":"Allows you to add a custom footer to outgoing email."
+				}
+			]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetMailSettings(context.TODO(), &InputGetMailSettings{Limit: 2})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetMailSettings{
+		Result: []*ResultGetMailSettings{
+			{
+				Name:        "address_whitelist",
+				Title:       "Address Whitelist",
+				Description: "Address / domains that should not be blocked.",
+				Enabled:     true,
+			},
+			{
+				Name:        "footer",
+				Title:       "Footer",
+				Description: "Allows you to add a custom footer to outgoing email.",
+				Enabled:     false,
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetMailSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetMailSettings(context.TODO(), &InputGetMailSettings{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetAddressWhitelistSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/address_whitelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true, "list": ["example.com", "user@example.net"]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAddressWhitelistSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetAddressWhitelistSettings{
+		Enabled: true,
+		List:    []string{"example.com", "user@example.net"},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetAddressWhitelistSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/address_whitelist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetAddressWhitelistSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateAddressWhitelistSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/address_whitelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true, "list": ["example.com", "user@example.net"]}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "list": ["example.com", "user@example.net"]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateAddressWhitelistSettings(context.TODO(), &InputUpdateAddressWhitelistSettings{
+		Enabled: true,
+		List:    &[]string{"example.com", "user@example.net"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateAddressWhitelistSettings{
+		Enabled: true,
+		List:    []string{"example.com", "user@example.net"},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateAddressWhitelistSettings_Clear(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/address_whitelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": false, "list": []}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": false, "list": []}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.UpdateAddressWhitelistSettings(context.TODO(), &InputUpdateAddressWhitelistSettings{
+		List: &[]string{},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestUpdateAddressWhitelistSettings_Enabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/address_whitelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "list": ["example.com"]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.UpdateAddressWhitelistSettings(context.TODO(), &InputUpdateAddressWhitelistSettings{
+		Enabled: true,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestGetBypassBounceManagementSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_bounce_management", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetBypassBounceManagementSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetBypassBounceManagementSettings{
+		Enabled: true,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetBypassBounceManagementSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_bounce_management", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetBypassBounceManagementSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateBypassBounceManagementSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_bounce_management", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateBypassBounceManagementSettings(context.TODO(), &InputUpdateBypassBounceManagementSettings{
+		Enabled: true,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateBypassBounceManagementSettings{
+		Enabled: true,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetBypassSpamManagementSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_spam_management", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetBypassSpamManagementSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetBypassSpamManagementSettings{
+		Enabled: true,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetBypassSpamManagementSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_spam_management", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetBypassSpamManagementSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateBypassSpamManagementSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_spam_management", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateBypassSpamManagementSettings(context.TODO(), &InputUpdateBypassSpamManagementSettings{
+		Enabled: true,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateBypassSpamManagementSettings{
+		Enabled: true,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetBypassUnsubscribeManagementSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_unsubscribe_management", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetBypassUnsubscribeManagementSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetBypassUnsubscribeManagementSettings{
+		Enabled: true,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetBypassUnsubscribeManagementSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_unsubscribe_management", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetBypassUnsubscribeManagementSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateBypassUnsubscribeManagementSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_unsubscribe_management", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateBypassUnsubscribeManagementSettings(context.TODO(), &InputUpdateBypassUnsubscribeManagementSettings{
+		Enabled: true,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateBypassUnsubscribeManagementSettings{
+		Enabled: true,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetFooterSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/footer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true, "html_content": "<p>footer</p>", "plain_content": "footer"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetFooterSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetFooterSettings{
+		Enabled:      true,
+		HTMLContent:  "<p>footer</p>",
+		PlainContent: "footer",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetFooterSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/footer", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetFooterSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateFooterSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/footer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true, "html_content": "<p>footer</p>", "plain_content": "footer"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "html_content": "<p>footer</p>", "plain_content": "footer"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateFooterSettings(context.TODO(), &InputUpdateFooterSettings{
+		Enabled:      true,
+		HTMLContent:  String("<p>footer</p>"),
+		PlainContent: String("footer"),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateFooterSettings{
+		Enabled:      true,
+		HTMLContent:  "<p>footer</p>",
+		PlainContent: "footer",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateFooterSettings_Clear(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/footer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true, "html_content": ""}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "html_content": "", "plain_content": "footer"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.UpdateFooterSettings(context.TODO(), &InputUpdateFooterSettings{
+		Enabled:     true,
+		HTMLContent: String(""),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestGetForwardBounceSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/forward_bounce", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true, "email": "bounces@example.com"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetForwardBounceSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetForwardBounceSettings{
+		Enabled: true,
+		Email:   "bounces@example.com",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetForwardBounceSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/forward_bounce", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetForwardBounceSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateForwardBounceSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/forward_bounce", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true, "email": "bounces@example.com"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "email": "bounces@example.com"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateForwardBounceSettings(context.TODO(), &InputUpdateForwardBounceSettings{
+		Enabled: true,
+		Email:   String("bounces@example.com"),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateForwardBounceSettings{
+		Enabled: true,
+		Email:   "bounces@example.com",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetForwardSpamSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/forward_spam", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true, "email": "spam@example.com,abuse@example.com"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetForwardSpamSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetForwardSpamSettings{
+		Enabled: true,
+		Email:   "spam@example.com,abuse@example.com",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetForwardSpamSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/forward_spam", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetForwardSpamSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateForwardSpamSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/forward_spam", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true, "email": "spam@example.com,abuse@example.com"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "email": "spam@example.com,abuse@example.com"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateForwardSpamSettings(context.TODO(), &InputUpdateForwardSpamSettings{
+		Enabled: true,
+		Email:   String("spam@example.com,abuse@example.com"),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateForwardSpamSettings{
+		Enabled: true,
+		Email:   "spam@example.com,abuse@example.com",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetLegacyTemplateSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/template", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true, "html_content": "<% body %>"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetLegacyTemplateSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetLegacyTemplateSettings{
+		Enabled:     true,
+		HTMLContent: "<% body %>",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetLegacyTemplateSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/template", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetLegacyTemplateSettings(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateLegacyTemplateSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/template", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true, "html_content": "<% body %>"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "html_content": "<% body %>"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateLegacyTemplateSettings(context.TODO(), &InputUpdateLegacyTemplateSettings{
+		Enabled:     true,
+		HTMLContent: String("<% body %>"),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateLegacyTemplateSettings{
+		Enabled:     true,
+		HTMLContent: "<% body %>",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateBypassBounceManagementSettings_Disable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mail_settings/bypass_bounce_management", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": false}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": false}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateBypassBounceManagementSettings(context.TODO(), &InputUpdateBypassBounceManagementSettings{})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateBypassBounceManagementSettings{}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
@@ -0,0 +1,466 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpsertContacts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{
+			"list_ids": ["list-1"],
+			"contacts": [{"email": "dummy@example.com", "first_name": "dummy", "custom_fields": {"e1_T": "dummy"}}]
+		}`, string(body))
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := fmt.Fprint(w, `{"job_id": "job-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpsertContacts(context.TODO(), &InputUpsertContacts{
+		ListIDs: []string{"list-1"},
+		Contacts: []*Contact{
+			{
+				Email:        "dummy@example.com",
+				FirstName:    "dummy",
+				CustomFields: map[string]interface{}{"e1_T": "dummy"},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpsertContacts{JobID: "job-1"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpsertContacts_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.UpsertContacts(context.TODO(), &InputUpsertContacts{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetContact(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/contact-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{
+			"id": "contact-1",
+			"email": "dummy@example.com",
+			"list_ids": ["list-1"],
+			"custom_fields": {"e1_T": "dummy"},
+			"created_at": "2024-01-01T00:00:00Z"
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetContact(context.TODO(), "contact-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &Contact{
+		ID:           "contact-1",
+		Email:        "dummy@example.com",
+		ListIDs:      []string{"list-1"},
+		CustomFields: map[string]interface{}{"e1_T": "dummy"},
+		CreatedAt:    "2024-01-01T00:00:00Z",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetContactsByEmails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/search/emails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if _, err := fmt.Fprint(w, `{
+			"result": {
+				"dummy@example.com": {"contact": {"id": "contact-1", "email": "dummy@example.com"}},
+				"unknown@example.com": {"error": "contact not found"}
+			}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetContactsByEmails(context.TODO(), &InputGetContactsByEmails{
+		Emails: []string{"dummy@example.com", "unknown@example.com"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetContactsByEmails{
+		Result: map[string]*ContactByEmail{
+			"dummy@example.com":   {Contact: &Contact{ID: "contact-1", Email: "dummy@example.com"}},
+			"unknown@example.com": {Error: "contact not found"},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteContacts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got := r.URL.Query().Get("ids"); got != "contact-1,contact-2" {
+			t.Errorf("ids: %v", got)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := fmt.Fprint(w, `{"job_id": "job-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.DeleteContacts(context.TODO(), &InputDeleteContacts{
+		IDs: []string{"contact-1", "contact-2"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputDeleteContacts{JobID: "job-1"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestSearchContacts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"query": "email LIKE 'dummy%'"}`, string(body))
+		if _, err := fmt.Fprint(w, `{
+			"result": [{"id": "contact-1", "email": "dummy@example.com"}],
+			"contact_count": 1,
+			"_metadata": {"self": "https://api.sendgrid.com/v3/marketing/contacts/search"}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.SearchContacts(context.TODO(), &InputSearchContacts{
+		Query: "email LIKE 'dummy%'",
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputSearchContacts{
+		Result:       []*Contact{{ID: "contact-1", Email: "dummy@example.com"}},
+		ContactCount: 1,
+		Metadata:     _Metadata{Self: "https://api.sendgrid.com/v3/marketing/contacts/search"},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetContactsCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{
+			"contact_count": 10,
+			"billable_count": 8,
+			"billable_breakdown": {"total": 8, "breakdown": {"parent": 5, "subuser": 3}}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetContactsCount(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetContactsCount{
+		ContactCount:  10,
+		BillableCount: 8,
+		BillableBreakdown: &BillableBreakdown{
+			Total:     8,
+			Breakdown: map[string]int64{"parent": 5, "subuser": 3},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestImportContacts(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	const csv = "email,skipped\ndummy@example.com,x\n"
+
+	mux.HandleFunc("/marketing/contacts/imports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"list_ids": ["list-1"], "file_type": "csv", "field_mappings": ["_rf0_T", null]}`, string(body))
+		if _, err := fmt.Fprintf(w, `{
+			"job_id": "job-1",
+			"upload_uri": "%s/v3/upload",
+			"upload_headers": [{"header": "Content-Type", "value": "text/csv"}]
+		}`, serverURL); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+		assert.Empty(t, r.TransferEncoding)
+		assert.Equal(t, int64(len(csv)), r.ContentLength)
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, csv, string(body))
+	})
+
+	// the signed upload must bypass middleware, which could log its URL
+	var paths []string
+	OptionMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			return next.Do(req)
+		})
+	})(client)
+
+	file, err := os.CreateTemp(t.TempDir(), "contacts-*.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(csv); err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := client.ImportContacts(context.TODO(), &InputImportContacts{
+		ListIDs:       []string{"list-1"},
+		FileType:      "csv",
+		FieldMappings: []*string{String("_rf0_T"), nil},
+	}, file)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+	assert.Equal(t, []string{"/v3/marketing/contacts/imports"}, paths)
+
+	want := &OutputImportContacts{
+		JobID:         "job-1",
+		UploadURI:     serverURL + "/v3/upload",
+		UploadHeaders: []*UploadHeader{{Header: "Content-Type", Value: "text/csv"}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestImportContacts_UploadFailed(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/imports", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprintf(w, `{"job_id": "job-1", "upload_uri": "%s/v3/upload?X-Amz-Signature=secret"}`, serverURL); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.ImportContacts(context.TODO(), &InputImportContacts{FileType: "csv"}, strings.NewReader("email\n"))
+	if assert.Error(t, err) {
+		assert.True(t, IsForbidden(err))
+		assert.NotContains(t, err.Error(), "secret")
+	}
+}
+
+func TestGetContactImport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/imports/job-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{
+			"id": "job-1",
+			"status": "completed",
+			"job_type": "upsert",
+			"results": {"requested_count": 2, "created_count": 1, "updated_count": 1},
+			"started_at": "2024-01-01T00:00:00Z",
+			"finished_at": "2024-01-01T00:01:00Z"
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetContactImport(context.TODO(), "job-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetContactImport{
+		ID:         "job-1",
+		Status:     "completed",
+		JobType:    "upsert",
+		Results:    &ContactImportResult{RequestedCount: 2, CreatedCount: 1, UpdatedCount: 1},
+		StartedAt:  "2024-01-01T00:00:00Z",
+		FinishedAt: "2024-01-01T00:01:00Z",
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestExportContacts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/exports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"list_ids": ["list-1"], "notifications": {"email": false}, "file_type": "csv"}`, string(body))
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := fmt.Fprint(w, `{"id": "export-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.ExportContacts(context.TODO(), &InputExportContacts{
+		ListIDs:       []string{"list-1"},
+		Notifications: &ContactExportNotification{},
+		FileType:      "csv",
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputExportContacts{ID: "export-1"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestWaitContactExport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/marketing/contacts/exports/export-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		polls++
+		body := `{"id": "export-1", "status": "pending"}`
+		if polls == 3 {
+			body = `{"id": "export-1", "status": "ready", "urls": ["https://example.com/export.csv.gzip"], "contact_count": 1}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.WaitContactExport(context.TODO(), "export-1", time.Millisecond)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetContactExport{
+		ID:           "export-1",
+		Status:       ContactExportReady,
+		URLs:         []string{"https://example.com/export.csv.gzip"},
+		ContactCount: 1,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+	assert.Equal(t, 3, polls)
+}
+
+func TestWaitContactExport_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/contacts/exports/export-1", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprint(w, `{"id": "export-1", "status": "failure", "message": "dummy"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.WaitContactExport(context.TODO(), "export-1", time.Millisecond)
+	assert.EqualError(t, err, "contact export export-1 failed: dummy")
+}
+
+func TestWaitContactExport_InvalidInterval(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		_, err := client.WaitContactExport(context.TODO(), "export-1", interval)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,160 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// types of a field definition
+const (
+	FieldTypeText   = "Text"
+	FieldTypeNumber = "Number"
+	FieldTypeDate   = "Date"
+)
+
+// FieldDateLayout is the layout of Date custom field values.
+const FieldDateLayout = "01/02/2006"
+
+type FieldDefinition struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	FieldType string `json:"field_type,omitempty"`
+	ReadOnly  bool   `json:"read_only,omitempty"`
+}
+
+type OutputGetFieldDefinitions struct {
+	CustomFields   []*FieldDefinition `json:"custom_fields,omitempty"`
+	ReservedFields []*FieldDefinition `json:"reserved_fields,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/custom-fields/get-all-field-definitions
+func (c *Client) GetFieldDefinitions(ctx context.Context) (*OutputGetFieldDefinitions, error) {
+	req, err := c.NewRequest("GET", "/marketing/field_definitions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetFieldDefinitions)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ValidateContact checks the custom fields of contact against the custom
+// field definitions: every key must be the ID of a defined field, and every
+// value must match its type. Date values are strings in FieldDateLayout;
+// time.Time values are rejected, as they marshal as RFC 3339.
+func (o *OutputGetFieldDefinitions) ValidateContact(contact *Contact) error {
+	fields := make(map[string]*FieldDefinition, len(o.CustomFields))
+	for _, f := range o.CustomFields {
+		fields[f.ID] = f
+	}
+
+	for id, v := range contact.CustomFields {
+		f, ok := fields[id]
+		if !ok {
+			return errors.Errorf("custom field %s is not defined", id)
+		}
+		if err := validateFieldValue(f, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateContacts calls ValidateContact on every contact, returning the first error.
+func (o *OutputGetFieldDefinitions) ValidateContacts(contacts []*Contact) error {
+	for i, contact := range contacts {
+		if err := o.ValidateContact(contact); err != nil {
+			return errors.Wrapf(err, "contacts[%d]", i)
+		}
+	}
+	return nil
+}
+
+func validateFieldValue(f *FieldDefinition, v interface{}) error {
+	ok := false
+	switch f.FieldType {
+	case FieldTypeText:
+		_, ok = v.(string)
+	case FieldTypeNumber:
+		switch v.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, json.Number:
+			ok = true
+		}
+	case FieldTypeDate:
+		switch v := v.(type) {
+		case time.Time:
+			return errors.Errorf("custom field %s (%s) must be a Date formatted with FieldDateLayout, but got time.Time", f.Name, f.ID)
+		case string:
+			_, err := time.Parse(FieldDateLayout, v)
+			ok = err == nil
+		}
+	default:
+		return errors.Errorf("custom field %s (%s) has unknown type %q", f.Name, f.ID, f.FieldType)
+	}
+
+	if !ok {
+		return errors.Errorf("custom field %s (%s) must be a %s, but got %T", f.Name, f.ID, f.FieldType, v)
+	}
+	return nil
+}
+
+type InputCreateFieldDefinition struct {
+	Name      string `json:"name"`
+	FieldType string `json:"field_type"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/custom-fields/create-custom-field-definition
+func (c *Client) CreateFieldDefinition(ctx context.Context, input *InputCreateFieldDefinition) (*FieldDefinition, error) {
+	req, err := c.NewRequest("POST", "/marketing/field_definitions", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(FieldDefinition)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateFieldDefinition struct {
+	Name string `json:"name"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/custom-fields/update-custom-field-definition
+func (c *Client) UpdateFieldDefinition(ctx context.Context, id string, input *InputUpdateFieldDefinition) (*FieldDefinition, error) {
+	path := fmt.Sprintf("/marketing/field_definitions/%s", id)
+
+	req, err := c.NewRequest("PATCH", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(FieldDefinition)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/custom-fields/delete-custom-field-definition
+func (c *Client) DeleteFieldDefinition(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/marketing/field_definitions/%s", id)
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
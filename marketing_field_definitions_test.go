@@ -0,0 +1,170 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFieldDefinitions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/field_definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{
+			"custom_fields": [{"id": "e1_T", "name": "plan", "field_type": "Text"}],
+			"reserved_fields": [{"id": "_rf0_T", "name": "first_name", "field_type": "Text", "read_only": false}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetFieldDefinitions(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetFieldDefinitions{
+		CustomFields:   []*FieldDefinition{{ID: "e1_T", Name: "plan", FieldType: FieldTypeText}},
+		ReservedFields: []*FieldDefinition{{ID: "_rf0_T", Name: "first_name", FieldType: FieldTypeText}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetFieldDefinitions_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/field_definitions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetFieldDefinitions(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestCreateFieldDefinition(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/field_definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "age", "field_type": "Number"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"id": "e2_N", "name": "age", "field_type": "Number"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.CreateFieldDefinition(context.TODO(), &InputCreateFieldDefinition{
+		Name:      "age",
+		FieldType: FieldTypeNumber,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &FieldDefinition{ID: "e2_N", Name: "age", FieldType: FieldTypeNumber}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateFieldDefinition(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/field_definitions/e2_N", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "years"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"id": "e2_N", "name": "years", "field_type": "Number"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateFieldDefinition(context.TODO(), "e2_N", &InputUpdateFieldDefinition{Name: "years"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &FieldDefinition{ID: "e2_N", Name: "years", FieldType: FieldTypeNumber}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteFieldDefinition(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/field_definitions/e2_N", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteFieldDefinition(context.TODO(), "e2_N")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestOutputGetFieldDefinitions_ValidateContacts(t *testing.T) {
+	definitions := &OutputGetFieldDefinitions{
+		CustomFields: []*FieldDefinition{
+			{ID: "e1_T", Name: "plan", FieldType: FieldTypeText},
+			{ID: "e2_N", Name: "age", FieldType: FieldTypeNumber},
+			{ID: "e3_D", Name: "birthday", FieldType: FieldTypeDate},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		err    string
+	}{
+		{"valid", map[string]interface{}{"e1_T": "pro", "e2_N": 30, "e3_D": "01/31/1990"}, ""},
+		{"small number", map[string]interface{}{"e2_N": int8(30)}, ""},
+		{"unsigned number", map[string]interface{}{"e2_N": uint16(30)}, ""},
+		{"formatted time", map[string]interface{}{"e3_D": time.Date(1990, 1, 31, 0, 0, 0, 0, time.UTC).Format(FieldDateLayout)}, ""},
+		{"undefined", map[string]interface{}{"e9_T": "pro"}, "contacts[0]: custom field e9_T is not defined"},
+		{"text", map[string]interface{}{"e1_T": 1}, "contacts[0]: custom field plan (e1_T) must be a Text, but got int"},
+		{"number", map[string]interface{}{"e2_N": "30"}, "contacts[0]: custom field age (e2_N) must be a Number, but got string"},
+		{"date", map[string]interface{}{"e3_D": "1990-01-31"}, "contacts[0]: custom field birthday (e3_D) must be a Date, but got string"},
+		{"time", map[string]interface{}{"e3_D": time.Now()}, "contacts[0]: custom field birthday (e3_D) must be a Date formatted with FieldDateLayout, but got time.Time"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := definitions.ValidateContacts([]*Contact{{Email: "dummy@example.com", CustomFields: tt.fields}})
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type List struct {
+	ID            string     `json:"id,omitempty"`
+	Name          string     `json:"name,omitempty"`
+	ContactCount  int64      `json:"contact_count,omitempty"`
+	ContactSample []*Contact `json:"contact_sample,omitempty"`
+	Metadata      _Metadata  `json:"_metadata,omitempty"`
+}
+
+type InputCreateList struct {
+	Name string `json:"name"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/lists/create-list
+func (c *Client) CreateList(ctx context.Context, input *InputCreateList) (*List, error) {
+	req, err := c.NewRequest("POST", "/marketing/lists", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(List)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputGetLists struct {
+	PageSize  int
+	PageToken string
+}
+
+type OutputGetLists struct {
+	Result   []*List   `json:"result,omitempty"`
+	Metadata _Metadata `json:"_metadata,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/lists/get-all-lists
+func (c *Client) GetLists(ctx context.Context, input *InputGetLists) (*OutputGetLists, error) {
+	u, err := url.Parse("/marketing/lists")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetLists)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// AllLists iterates over every list, following _metadata.next across pages.
+func (c *Client) AllLists(ctx context.Context, input *InputGetLists) iter.Seq2[*List, error] {
+	in := InputGetLists{}
+	if input != nil {
+		in = *input
+	}
+	if in.PageSize == 0 {
+		in.PageSize = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*List] {
+		in := in
+		return func(ctx context.Context) ([]*List, bool, error) {
+			r, err := c.GetLists(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.PageToken = pageToken(r.Metadata.Next)
+			return r.Result, in.PageToken != "", nil
+		}
+	})
+}
+
+type InputGetList struct {
+	// ContactSample includes up to 50 of the most recent contacts of the list.
+	ContactSample bool
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/lists/get-a-list-by-id
+func (c *Client) GetList(ctx context.Context, id string, input *InputGetList) (*List, error) {
+	u, err := url.Parse(fmt.Sprintf("/marketing/lists/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.ContactSample {
+		q.Set("contact_sample", "true")
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(List)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateList struct {
+	Name string `json:"name"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/lists/update-list
+func (c *Client) UpdateList(ctx context.Context, id string, input *InputUpdateList) (*List, error) {
+	path := fmt.Sprintf("/marketing/lists/%s", id)
+
+	req, err := c.NewRequest("PATCH", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(List)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputDeleteList struct {
+	// DeleteContacts also deletes the contacts of the list from the account.
+	DeleteContacts bool
+}
+
+type OutputDeleteList struct {
+	JobID string `json:"job_id,omitempty"`
+}
+
+// DeleteList deletes a list. JobID is only set when the contacts are deleted too.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/lists/delete-a-list
+func (c *Client) DeleteList(ctx context.Context, id string, input *InputDeleteList) (*OutputDeleteList, error) {
+	u, err := url.Parse(fmt.Sprintf("/marketing/lists/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.DeleteContacts {
+		q.Set("delete_contacts", "true")
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputDeleteList)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputGetListContactsCount struct {
+	ContactCount  int64 `json:"contact_count"`
+	BillableCount int64 `json:"billable_count,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/lists/get-list-contact-count
+func (c *Client) GetListContactsCount(ctx context.Context, id string) (*OutputGetListContactsCount, error) {
+	path := fmt.Sprintf("/marketing/lists/%s/contacts/count", id)
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetListContactsCount)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputRemoveListContacts struct {
+	JobID string `json:"job_id,omitempty"`
+}
+
+// RemoveListContacts removes contacts from a list without deleting them.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/lists/remove-contacts-from-a-list
+func (c *Client) RemoveListContacts(ctx context.Context, id string, contactIDs []string) (*OutputRemoveListContacts, error) {
+	u, err := url.Parse(fmt.Sprintf("/marketing/lists/%s/contacts", id))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("contact_ids", strings.Join(contactIDs, ","))
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputRemoveListContacts)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,254 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "dummy"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{"id": "list-1", "name": "dummy", "contact_count": 0}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.CreateList(context.TODO(), &InputCreateList{Name: "dummy"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &List{ID: "list-1", Name: "dummy"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestCreateList_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.CreateList(context.TODO(), &InputCreateList{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetLists(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "page_size=10" {
+			t.Errorf("query: %v", got)
+		}
+		if _, err := fmt.Fprint(w, `{
+			"result": [{"id": "list-1", "name": "dummy", "contact_count": 1}],
+			"_metadata": {"count": 1}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetLists(context.TODO(), &InputGetLists{PageSize: 10})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetLists{
+		Result:   []*List{{ID: "list-1", Name: "dummy", ContactCount: 1}},
+		Metadata: _Metadata{Count: 1},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestAllLists(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists", func(w http.ResponseWriter, r *http.Request) {
+		body := `{"result": [{"id": "list-1"}], "_metadata": {"next": "https://api.sendgrid.com/v3/marketing/lists?page_size=1&page_token=next"}}`
+		if r.URL.Query().Get("page_token") == "next" {
+			body = `{"result": [{"id": "list-2"}], "_metadata": {}}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var ids []string
+	for list, err := range client.AllLists(context.TODO(), &InputGetLists{PageSize: 1}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, list.ID)
+	}
+	assert.Equal(t, []string{"list-1", "list-2"}, ids)
+}
+
+func TestGetList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists/list-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "contact_sample=true" {
+			t.Errorf("query: %v", got)
+		}
+		if _, err := fmt.Fprint(w, `{
+			"id": "list-1",
+			"name": "dummy",
+			"contact_count": 1,
+			"contact_sample": [{"id": "contact-1", "email": "dummy@example.com"}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetList(context.TODO(), "list-1", &InputGetList{ContactSample: true})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &List{
+		ID:            "list-1",
+		Name:          "dummy",
+		ContactCount:  1,
+		ContactSample: []*Contact{{ID: "contact-1", Email: "dummy@example.com"}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists/list-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "renamed"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"id": "list-1", "name": "renamed"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateList(context.TODO(), "list-1", &InputUpdateList{Name: "renamed"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &List{ID: "list-1", Name: "renamed"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists/list-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got := r.URL.RawQuery; got != "delete_contacts=true" {
+			t.Errorf("query: %v", got)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := fmt.Fprint(w, `{"job_id": "job-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.DeleteList(context.TODO(), "list-1", &InputDeleteList{DeleteContacts: true})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputDeleteList{JobID: "job-1"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetListContactsCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists/list-1/contacts/count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"contact_count": 10, "billable_count": 8}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetListContactsCount(context.TODO(), "list-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetListContactsCount{ContactCount: 10, BillableCount: 8}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestRemoveListContacts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/lists/list-1/contacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got := r.URL.Query().Get("contact_ids"); got != "contact-1,contact-2" {
+			t.Errorf("contact_ids: %v", got)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := fmt.Fprint(w, `{"job_id": "job-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.RemoveListContacts(context.TODO(), "list-1", []string{"contact-1", "contact-2"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputRemoveListContacts{JobID: "job-1"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
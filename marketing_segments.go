@@ -0,0 +1,186 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Segment struct {
+	ID               string         `json:"id,omitempty"`
+	Name             string         `json:"name,omitempty"`
+	QueryDSL         string         `json:"query_dsl,omitempty"`
+	ContactsCount    int64          `json:"contacts_count,omitempty"`
+	ContactsSample   []*Contact     `json:"contacts_sample,omitempty"`
+	CreatedAt        string         `json:"created_at,omitempty"`
+	UpdatedAt        string         `json:"updated_at,omitempty"`
+	SampleUpdatedAt  string         `json:"sample_updated_at,omitempty"`
+	NextSampleUpdate string         `json:"next_sample_update,omitempty"`
+	ParentListIDs    []string       `json:"parent_list_ids,omitempty"`
+	QueryVersion     string         `json:"query_version,omitempty"`
+	Status           *SegmentStatus `json:"status,omitempty"`
+	RefreshesUsed    int64          `json:"refreshes_used,omitempty"`
+	MaxRefreshes     int64          `json:"max_refreshes,omitempty"`
+	LastRefreshedAt  string         `json:"last_refreshed_at,omitempty"`
+}
+
+type SegmentStatus struct {
+	QueryValidation string `json:"query_validation,omitempty"`
+	ErrorMessage    string `json:"error_message,omitempty"`
+}
+
+type InputCreateSegment struct {
+	Name string `json:"name"`
+	// QueryDSL is an SGQL query, e.g. "SELECT c.contact_id FROM contact_data AS c WHERE c.city = 'Tokyo'"
+	QueryDSL      string   `json:"query_dsl"`
+	ParentListIDs []string `json:"parent_list_ids,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/segmenting-contacts-v2/create-segment
+func (c *Client) CreateSegment(ctx context.Context, input *InputCreateSegment) (*Segment, error) {
+	req, err := c.NewRequest("POST", "/marketing/segments/2.0", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(Segment)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputGetSegments struct {
+	IDs           []string
+	ParentListIDs []string
+	// NoParentListID returns only the segments built on all contacts.
+	NoParentListID bool
+}
+
+type OutputGetSegments struct {
+	Results  []*Segment `json:"results,omitempty"`
+	Metadata _Metadata  `json:"_metadata,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/segmenting-contacts-v2/get-list-of-segments
+func (c *Client) GetSegments(ctx context.Context, input *InputGetSegments) (*OutputGetSegments, error) {
+	u, err := url.Parse("/marketing/segments/2.0")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if len(input.IDs) > 0 {
+		q.Set("ids", strings.Join(input.IDs, ","))
+	}
+	if len(input.ParentListIDs) > 0 {
+		q.Set("parent_list_ids", strings.Join(input.ParentListIDs, ","))
+	}
+	if input.NoParentListID {
+		q.Set("no_parent_list_id", "true")
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetSegments)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputGetSegment struct {
+	ContactsSample bool
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/segmenting-contacts-v2/get-segment-by-id
+func (c *Client) GetSegment(ctx context.Context, id string, input *InputGetSegment) (*Segment, error) {
+	u, err := url.Parse(fmt.Sprintf("/marketing/segments/2.0/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.ContactsSample {
+		q.Set("contacts_sample", "true")
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(Segment)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateSegment struct {
+	Name     string `json:"name,omitempty"`
+	QueryDSL string `json:"query_dsl,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/segmenting-contacts-v2/update-segment
+func (c *Client) UpdateSegment(ctx context.Context, id string, input *InputUpdateSegment) (*Segment, error) {
+	path := fmt.Sprintf("/marketing/segments/2.0/%s", id)
+
+	req, err := c.NewRequest("PATCH", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(Segment)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/segmenting-contacts-v2/delete-segment
+func (c *Client) DeleteSegment(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/marketing/segments/2.0/%s", id)
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InputRefreshSegment struct {
+	// UserTimeZone is an IANA time zone used to count the daily refresh limit, e.g. "Asia/Tokyo".
+	UserTimeZone string `json:"user_time_zone"`
+}
+
+type OutputRefreshSegment struct {
+	JobID string `json:"job_id,omitempty"`
+}
+
+// RefreshSegment requests a manual refresh of the contacts of a segment.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/segmenting-contacts-v2/manually-refresh-a-segment
+func (c *Client) RefreshSegment(ctx context.Context, id string, input *InputRefreshSegment) (*OutputRefreshSegment, error) {
+	path := fmt.Sprintf("/marketing/segments/2.0/refresh/%s", id)
+
+	req, err := c.NewRequest("POST", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputRefreshSegment)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,224 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSegment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/segments/2.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{
+			"name": "dummy",
+			"query_dsl": "SELECT c.contact_id FROM contact_data AS c WHERE c.city = 'Tokyo'",
+			"parent_list_ids": ["list-1"]
+		}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{
+			"id": "segment-1",
+			"name": "dummy",
+			"query_dsl": "SELECT c.contact_id FROM contact_data AS c WHERE c.city = 'Tokyo'",
+			"parent_list_ids": ["list-1"],
+			"query_version": "2",
+			"status": {"query_validation": "VALID"}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.CreateSegment(context.TODO(), &InputCreateSegment{
+		Name:          "dummy",
+		QueryDSL:      "SELECT c.contact_id FROM contact_data AS c WHERE c.city = 'Tokyo'",
+		ParentListIDs: []string{"list-1"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &Segment{
+		ID:            "segment-1",
+		Name:          "dummy",
+		QueryDSL:      "SELECT c.contact_id FROM contact_data AS c WHERE c.city = 'Tokyo'",
+		ParentListIDs: []string{"list-1"},
+		QueryVersion:  "2",
+		Status:        &SegmentStatus{QueryValidation: "VALID"},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestCreateSegment_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/segments/2.0", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.CreateSegment(context.TODO(), &InputCreateSegment{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetSegments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/segments/2.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("parent_list_ids"); got != "list-1,list-2" {
+			t.Errorf("parent_list_ids: %v", got)
+		}
+		if _, err := fmt.Fprint(w, `{
+			"results": [{"id": "segment-1", "name": "dummy", "contacts_count": 1, "parent_list_ids": ["list-1"]}],
+			"_metadata": {"self": "https://api.sendgrid.com/v3/marketing/segments/2.0"}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSegments(context.TODO(), &InputGetSegments{
+		ParentListIDs: []string{"list-1", "list-2"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetSegments{
+		Results: []*Segment{
+			{ID: "segment-1", Name: "dummy", ContactsCount: 1, ParentListIDs: []string{"list-1"}},
+		},
+		Metadata: _Metadata{Self: "https://api.sendgrid.com/v3/marketing/segments/2.0"},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSegment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/segments/2.0/segment-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "contacts_sample=true" {
+			t.Errorf("query: %v", got)
+		}
+		if _, err := fmt.Fprint(w, `{
+			"id": "segment-1",
+			"name": "dummy",
+			"contacts_count": 1,
+			"contacts_sample": [{"id": "contact-1", "email": "dummy@example.com"}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSegment(context.TODO(), "segment-1", &InputGetSegment{ContactsSample: true})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &Segment{
+		ID:             "segment-1",
+		Name:           "dummy",
+		ContactsCount:  1,
+		ContactsSample: []*Contact{{ID: "contact-1", Email: "dummy@example.com"}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateSegment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/segments/2.0/segment-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "renamed"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"id": "segment-1", "name": "renamed"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateSegment(context.TODO(), "segment-1", &InputUpdateSegment{Name: "renamed"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &Segment{ID: "segment-1", Name: "renamed"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteSegment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/segments/2.0/segment-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := client.DeleteSegment(context.TODO(), "segment-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestRefreshSegment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/segments/2.0/refresh/segment-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"user_time_zone": "Asia/Tokyo"}`, string(body))
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := fmt.Fprint(w, `{"job_id": "job-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.RefreshSegment(context.TODO(), "segment-1", &InputRefreshSegment{UserTimeZone: "Asia/Tokyo"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputRefreshSegment{JobID: "job-1"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
@@ -0,0 +1,381 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// status of a single send
+const (
+	SingleSendDraft     = "draft"
+	SingleSendScheduled = "scheduled"
+	SingleSendTriggered = "triggered"
+)
+
+// SingleSendNow schedules a single send immediately.
+const SingleSendNow = "now"
+
+type SingleSend struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	Categories  []string               `json:"categories,omitempty"`
+	SendAt      string                 `json:"send_at,omitempty"`
+	SendTo      *SingleSendTo          `json:"send_to,omitempty"`
+	EmailConfig *SingleSendEmailConfig `json:"email_config,omitempty"`
+	Warnings    []*SingleSendWarning   `json:"warnings,omitempty"`
+	CreatedAt   string                 `json:"created_at,omitempty"`
+	UpdatedAt   string                 `json:"updated_at,omitempty"`
+}
+
+type SingleSendTo struct {
+	ListIDs    []string `json:"list_ids,omitempty"`
+	SegmentIDs []string `json:"segment_ids,omitempty"`
+	All        bool     `json:"all,omitempty"`
+}
+
+// AddLists sends to the contacts of lists.
+func (s *SingleSendTo) AddLists(lists ...*List) *SingleSendTo {
+	for _, l := range lists {
+		s.ListIDs = append(s.ListIDs, l.ID)
+	}
+	return s
+}
+
+// AddSegments sends to the contacts of segments.
+func (s *SingleSendTo) AddSegments(segments ...*Segment) *SingleSendTo {
+	for _, seg := range segments {
+		s.SegmentIDs = append(s.SegmentIDs, seg.ID)
+	}
+	return s
+}
+
+type SingleSendEmailConfig struct {
+	Subject              string `json:"subject,omitempty"`
+	HTMLContent          string `json:"html_content,omitempty"`
+	PlainContent         string `json:"plain_content,omitempty"`
+	GeneratePlainContent bool   `json:"generate_plain_content,omitempty"`
+	DesignID             string `json:"design_id,omitempty"`
+	Editor               string `json:"editor,omitempty"`
+	SuppressionGroupID   int64  `json:"suppression_group_id,omitempty"`
+	CustomUnsubscribeURL string `json:"custom_unsubscribe_url,omitempty"`
+	SenderID             int64  `json:"sender_id,omitempty"`
+	IPPool               string `json:"ip_pool,omitempty"`
+}
+
+// SetDesign renders the single send with d.
+func (e *SingleSendEmailConfig) SetDesign(d *Design) *SingleSendEmailConfig {
+	e.DesignID = d.ID
+	return e
+}
+
+// SetSender sends the single send from a verified sender.
+func (e *SingleSendEmailConfig) SetSender(s *VerifiedSender) *SingleSendEmailConfig {
+	e.SenderID = s.ID
+	return e
+}
+
+// SetSuppressionGroup sets the unsubscribe group of the single send.
+func (e *SingleSendEmailConfig) SetSuppressionGroup(g *SuppressionGroup) *SingleSendEmailConfig {
+	e.SuppressionGroupID = g.ID
+	return e
+}
+
+type SingleSendWarning struct {
+	Field     string `json:"field,omitempty"`
+	Message   string `json:"message,omitempty"`
+	WarningID string `json:"warning_id,omitempty"`
+}
+
+type InputCreateSingleSend struct {
+	Name        string                 `json:"name"`
+	Categories  []string               `json:"categories,omitempty"`
+	SendAt      string                 `json:"send_at,omitempty"`
+	SendTo      *SingleSendTo          `json:"send_to,omitempty"`
+	EmailConfig *SingleSendEmailConfig `json:"email_config,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/create-single-send
+func (c *Client) CreateSingleSend(ctx context.Context, input *InputCreateSingleSend) (*SingleSend, error) {
+	req, err := c.NewRequest("POST", "/marketing/singlesends", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(SingleSend)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputGetSingleSends struct {
+	PageSize  int
+	PageToken string
+}
+
+type OutputGetSingleSends struct {
+	Result   []*SingleSend `json:"result,omitempty"`
+	Metadata _Metadata     `json:"_metadata,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/get-all-single-sends
+func (c *Client) GetSingleSends(ctx context.Context, input *InputGetSingleSends) (*OutputGetSingleSends, error) {
+	u, err := url.Parse("/marketing/singlesends")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetSingleSends)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// AllSingleSends iterates over every single send, following _metadata.next across pages.
+func (c *Client) AllSingleSends(ctx context.Context, input *InputGetSingleSends) iter.Seq2[*SingleSend, error] {
+	in := InputGetSingleSends{}
+	if input != nil {
+		in = *input
+	}
+	if in.PageSize == 0 {
+		in.PageSize = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*SingleSend] {
+		in := in
+		return func(ctx context.Context) ([]*SingleSend, bool, error) {
+			r, err := c.GetSingleSends(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.PageToken = pageToken(r.Metadata.Next)
+			return r.Result, in.PageToken != "", nil
+		}
+	})
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/get-single-send-by-id
+func (c *Client) GetSingleSend(ctx context.Context, id string) (*SingleSend, error) {
+	path := fmt.Sprintf("/marketing/singlesends/%s", id)
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(SingleSend)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateSingleSend struct {
+	Name        string                 `json:"name,omitempty"`
+	Categories  []string               `json:"categories,omitempty"`
+	SendAt      string                 `json:"send_at,omitempty"`
+	SendTo      *SingleSendTo          `json:"send_to,omitempty"`
+	EmailConfig *SingleSendEmailConfig `json:"email_config,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/update-single-send
+func (c *Client) UpdateSingleSend(ctx context.Context, id string, input *InputUpdateSingleSend) (*SingleSend, error) {
+	path := fmt.Sprintf("/marketing/singlesends/%s", id)
+
+	req, err := c.NewRequest("PATCH", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(SingleSend)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputDuplicateSingleSend struct {
+	Name string `json:"name,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/duplicate-single-send
+func (c *Client) DuplicateSingleSend(ctx context.Context, id string, input *InputDuplicateSingleSend) (*SingleSend, error) {
+	path := fmt.Sprintf("/marketing/singlesends/%s", id)
+
+	req, err := c.NewRequest("POST", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(SingleSend)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/delete-single-send-by-id
+func (c *Client) DeleteSingleSend(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/marketing/singlesends/%s", id)
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/bulk-delete-single-sends
+func (c *Client) DeleteSingleSends(ctx context.Context, ids []string) error {
+	u, err := url.Parse("/marketing/singlesends")
+	if err != nil {
+		return err
+	}
+
+	q := u.Query()
+	q.Set("ids", strings.Join(ids, ","))
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InputSearchSingleSends struct {
+	Name       string   `json:"name,omitempty"`
+	Status     []string `json:"status,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+	PageSize   int      `json:"-"`
+	PageToken  string   `json:"-"`
+}
+
+type OutputSearchSingleSends struct {
+	Result   []*SingleSend `json:"result,omitempty"`
+	Metadata _Metadata     `json:"_metadata,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/get-single-sends-search
+func (c *Client) SearchSingleSends(ctx context.Context, input *InputSearchSingleSends) (*OutputSearchSingleSends, error) {
+	u, err := url.Parse("/marketing/singlesends/search")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("POST", u.String(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputSearchSingleSends)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputScheduleSingleSend struct {
+	// SendAt is an ISO 8601 time or SingleSendNow.
+	SendAt string `json:"send_at"`
+}
+
+// SetSendAt schedules the single send at t.
+func (i *InputScheduleSingleSend) SetSendAt(t time.Time) *InputScheduleSingleSend {
+	i.SendAt = t.UTC().Format(time.RFC3339)
+	return i
+}
+
+type OutputScheduleSingleSend struct {
+	SendAt string `json:"send_at,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/schedule-single-send
+func (c *Client) ScheduleSingleSend(ctx context.Context, id string, input *InputScheduleSingleSend) (*OutputScheduleSingleSend, error) {
+	path := fmt.Sprintf("/marketing/singlesends/%s/schedule", id)
+
+	req, err := c.NewRequest("PUT", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputScheduleSingleSend)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UnscheduleSingleSend cancels a scheduled single send and returns it to draft.
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/delete-single-send-schedule
+func (c *Client) UnscheduleSingleSend(ctx context.Context, id string) (*SingleSend, error) {
+	path := fmt.Sprintf("/marketing/singlesends/%s/schedule", id)
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(SingleSend)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputGetSingleSendCategories struct {
+	Categories []string `json:"categories,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/get-all-categories
+func (c *Client) GetSingleSendCategories(ctx context.Context) (*OutputGetSingleSendCategories, error) {
+	req, err := c.NewRequest("GET", "/marketing/singlesends/categories", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetSingleSendCategories)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
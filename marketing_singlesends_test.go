@@ -0,0 +1,393 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSingleSend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{
+			"name": "dummy",
+			"send_to": {"list_ids": ["list-1"], "segment_ids": ["segment-1"]},
+			"email_config": {"subject": "hello", "design_id": "design-1", "suppression_group_id": 12, "sender_id": 34}
+		}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{
+			"id": "ss-1",
+			"name": "dummy",
+			"status": "draft",
+			"send_to": {"list_ids": ["list-1"], "segment_ids": ["segment-1"]},
+			"email_config": {"subject": "hello", "design_id": "design-1", "suppression_group_id": 12, "sender_id": 34}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	sendTo := new(SingleSendTo).AddLists(&List{ID: "list-1"}).AddSegments(&Segment{ID: "segment-1"})
+	config := &SingleSendEmailConfig{Subject: "hello"}
+	config.SetDesign(&Design{ID: "design-1"}).
+		SetSuppressionGroup(&SuppressionGroup{ID: 12}).
+		SetSender(&VerifiedSender{ID: 34})
+
+	expected, err := client.CreateSingleSend(context.TODO(), &InputCreateSingleSend{
+		Name:        "dummy",
+		SendTo:      sendTo,
+		EmailConfig: config,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &SingleSend{
+		ID:          "ss-1",
+		Name:        "dummy",
+		Status:      SingleSendDraft,
+		SendTo:      sendTo,
+		EmailConfig: config,
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestCreateSingleSend_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.CreateSingleSend(context.TODO(), &InputCreateSingleSend{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAllSingleSends(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprintf(w, `{
+				"result": [{"id": "ss-1"}],
+				"_metadata": {"next": "%s/v3/marketing/singlesends?page_size=100&page_token=next"}
+			}`, serverURL)
+		case "next":
+			fmt.Fprint(w, `{"result": [{"id": "ss-2"}], "_metadata": {}}`)
+		}
+	})
+
+	var ids []string
+	for s, err := range client.AllSingleSends(context.TODO(), nil) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, s.ID)
+	}
+	assert.Equal(t, []string{"ss-1", "ss-2"}, ids)
+}
+
+func TestGetSingleSend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{
+			"id": "ss-1",
+			"name": "dummy",
+			"status": "scheduled",
+			"categories": ["news"],
+			"send_at": "2026-01-02T03:04:05Z",
+			"warnings": [{"field": "email_config.subject", "message": "subject is empty"}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSingleSend(context.TODO(), "ss-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &SingleSend{
+		ID:         "ss-1",
+		Name:       "dummy",
+		Status:     SingleSendScheduled,
+		Categories: []string{"news"},
+		SendAt:     "2026-01-02T03:04:05Z",
+		Warnings: []*SingleSendWarning{
+			{Field: "email_config.subject", Message: "subject is empty"},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSingleSend_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetSingleSend(context.TODO(), "ss-1")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUpdateSingleSend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "renamed", "categories": ["news"]}`, string(body))
+		if _, err := fmt.Fprint(w, `{"id": "ss-1", "name": "renamed", "categories": ["news"]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateSingleSend(context.TODO(), "ss-1", &InputUpdateSingleSend{
+		Name:       "renamed",
+		Categories: []string{"news"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &SingleSend{ID: "ss-1", Name: "renamed", Categories: []string{"news"}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDuplicateSingleSend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "copy"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{"id": "ss-2", "name": "copy", "status": "draft"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.DuplicateSingleSend(context.TODO(), "ss-1", &InputDuplicateSingleSend{Name: "copy"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &SingleSend{ID: "ss-2", Name: "copy", Status: SingleSendDraft}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestDeleteSingleSend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteSingleSend(context.TODO(), "ss-1"); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestDeleteSingleSends(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		assert.Equal(t, "ss-1,ss-2", r.URL.Query().Get("ids"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteSingleSends(context.TODO(), []string{"ss-1", "ss-2"}); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestDeleteSingleSends_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	if err := client.DeleteSingleSends(context.TODO(), nil); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestSearchSingleSends(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		assert.Equal(t, "page_size=10", r.URL.RawQuery)
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name": "dum", "status": ["draft", "scheduled"]}`, string(body))
+		if _, err := fmt.Fprint(w, `{"result": [{"id": "ss-1", "name": "dummy"}], "_metadata": {"count": 1}}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.SearchSingleSends(context.TODO(), &InputSearchSingleSends{
+		Name:     "dum",
+		Status:   []string{SingleSendDraft, SingleSendScheduled},
+		PageSize: 10,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputSearchSingleSends{
+		Result:   []*SingleSend{{ID: "ss-1", Name: "dummy"}},
+		Metadata: _Metadata{Count: 1},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestScheduleSingleSend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1/schedule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"send_at": "2026-01-02T03:04:05Z"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{"send_at": "2026-01-02T03:04:05Z", "status": "scheduled"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	jst := time.FixedZone("JST", 9*60*60)
+	input := new(InputScheduleSingleSend).SetSendAt(time.Date(2026, 1, 2, 12, 4, 5, 0, jst))
+	expected, err := client.ScheduleSingleSend(context.TODO(), "ss-1", input)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputScheduleSingleSend{SendAt: "2026-01-02T03:04:05Z", Status: SingleSendScheduled}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestScheduleSingleSend_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1/schedule", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.ScheduleSingleSend(context.TODO(), "ss-1", &InputScheduleSingleSend{SendAt: SingleSendNow})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestUnscheduleSingleSend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/ss-1/schedule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if _, err := fmt.Fprint(w, `{"id": "ss-1", "status": "draft"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UnscheduleSingleSend(context.TODO(), "ss-1")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &SingleSend{ID: "ss-1", Status: SingleSendDraft}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSingleSendCategories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/singlesends/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"categories": ["news", "promo"]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSingleSendCategories(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetSingleSendCategories{Categories: []string{"news", "promo"}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
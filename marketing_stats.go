@@ -0,0 +1,330 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// aggregation of marketing stats
+const (
+	AggregatedByTotal = "total"
+	AggregatedByDay   = "day"
+	AggregatedByWeek  = "week"
+	AggregatedByMonth = "month"
+)
+
+type MarketingStats struct {
+	BounceDrops       int64 `json:"bounce_drops,omitempty"`
+	Bounces           int64 `json:"bounces,omitempty"`
+	Clicks            int64 `json:"clicks,omitempty"`
+	UniqueClicks      int64 `json:"unique_clicks,omitempty"`
+	Delivered         int64 `json:"delivered,omitempty"`
+	InvalidEmails     int64 `json:"invalid_emails,omitempty"`
+	Opens             int64 `json:"opens,omitempty"`
+	UniqueOpens       int64 `json:"unique_opens,omitempty"`
+	Requests          int64 `json:"requests,omitempty"`
+	SpamReportDrops   int64 `json:"spam_report_drops,omitempty"`
+	SpamReports       int64 `json:"spam_reports,omitempty"`
+	Unsubscribes      int64 `json:"unsubscribes,omitempty"`
+	ApplePrivacyOpens int64 `json:"apple_privacy_opens,omitempty"`
+}
+
+type SingleSendStats struct {
+	ID          string          `json:"id,omitempty"`
+	ABVariation string          `json:"ab_variation,omitempty"`
+	ABPhase     string          `json:"ab_phase,omitempty"`
+	Aggregation string          `json:"aggregation,omitempty"`
+	Stats       *MarketingStats `json:"stats,omitempty"`
+}
+
+type InputGetAllSingleSendStats struct {
+	SingleSendIDs []string
+	PageSize      int
+	PageToken     string
+}
+
+type OutputGetSingleSendStats struct {
+	Results  []*SingleSendStats `json:"results,omitempty"`
+	Metadata _Metadata          `json:"_metadata,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/marketing-campaign-stats/get-all-single-sends-stats
+func (c *Client) GetAllSingleSendStats(ctx context.Context, input *InputGetAllSingleSendStats) (*OutputGetSingleSendStats, error) {
+	u, err := url.Parse("/marketing/stats/singlesends")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if len(input.SingleSendIDs) > 0 {
+		q.Set("singlesend_ids", strings.Join(input.SingleSendIDs, ","))
+	}
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetSingleSendStats)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputGetSingleSendStats struct {
+	// AggregatedBy is AggregatedByTotal (default), AggregatedByDay, AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	StartDate    time.Time
+	EndDate      time.Time
+	// Timezone is an IANA name, e.g. "America/Chicago", the dates are interpreted in.
+	Timezone string
+	// GroupBy splits stats by "ab_variation" and/or "ab_phase".
+	GroupBy   []string
+	PageSize  int
+	PageToken string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/marketing-campaign-stats/get-single-send-stats-by-id
+func (c *Client) GetSingleSendStats(ctx context.Context, id string, input *InputGetSingleSendStats) (*OutputGetSingleSendStats, error) {
+	u, err := url.Parse(fmt.Sprintf("/marketing/stats/singlesends/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.AggregatedBy != "" {
+		q.Set("aggregated_by", input.AggregatedBy)
+	}
+	if !input.StartDate.IsZero() {
+		q.Set("start_date", input.StartDate.Format(statsDateLayout))
+	}
+	if !input.EndDate.IsZero() {
+		q.Set("end_date", input.EndDate.Format(statsDateLayout))
+	}
+	if input.Timezone != "" {
+		q.Set("timezone", input.Timezone)
+	}
+	if len(input.GroupBy) > 0 {
+		q.Set("group_by", strings.Join(input.GroupBy, ","))
+	}
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetSingleSendStats)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type AutomationStats struct {
+	ID          string          `json:"id,omitempty"`
+	StepID      string          `json:"step_id,omitempty"`
+	Aggregation string          `json:"aggregation,omitempty"`
+	Stats       *MarketingStats `json:"stats,omitempty"`
+}
+
+type InputGetAllAutomationStats struct {
+	AutomationIDs []string
+	PageSize      int
+	PageToken     string
+}
+
+type OutputGetAutomationStats struct {
+	Results  []*AutomationStats `json:"results,omitempty"`
+	Metadata _Metadata          `json:"_metadata,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/marketing-campaign-stats/get-all-automation-stats
+func (c *Client) GetAllAutomationStats(ctx context.Context, input *InputGetAllAutomationStats) (*OutputGetAutomationStats, error) {
+	u, err := url.Parse("/marketing/stats/automations")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if len(input.AutomationIDs) > 0 {
+		q.Set("automation_ids", strings.Join(input.AutomationIDs, ","))
+	}
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetAutomationStats)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// AllAutomationStats iterates over the stats of every automation, following _metadata.next across pages.
+func (c *Client) AllAutomationStats(ctx context.Context, input *InputGetAllAutomationStats) iter.Seq2[*AutomationStats, error] {
+	in := InputGetAllAutomationStats{}
+	if input != nil {
+		in = *input
+	}
+	if in.PageSize == 0 {
+		in.PageSize = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*AutomationStats] {
+		in := in
+		return func(ctx context.Context) ([]*AutomationStats, bool, error) {
+			r, err := c.GetAllAutomationStats(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.PageToken = pageToken(r.Metadata.Next)
+			return r.Results, in.PageToken != "", nil
+		}
+	})
+}
+
+type InputGetAutomationStats struct {
+	// GroupBy splits stats by "step_id".
+	GroupBy []string
+	StepIDs []string
+	// AggregatedBy is AggregatedByTotal (default), AggregatedByDay, AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	StartDate    time.Time
+	EndDate      time.Time
+	// Timezone is an IANA name, e.g. "America/Chicago", the dates are interpreted in.
+	Timezone  string
+	PageSize  int
+	PageToken string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/marketing-campaign-stats/get-an-automation-stats
+func (c *Client) GetAutomationStats(ctx context.Context, id string, input *InputGetAutomationStats) (*OutputGetAutomationStats, error) {
+	u, err := url.Parse(fmt.Sprintf("/marketing/stats/automations/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if len(input.GroupBy) > 0 {
+		q.Set("group_by", strings.Join(input.GroupBy, ","))
+	}
+	if len(input.StepIDs) > 0 {
+		q.Set("step_ids", strings.Join(input.StepIDs, ","))
+	}
+	if input.AggregatedBy != "" {
+		q.Set("aggregated_by", input.AggregatedBy)
+	}
+	if !input.StartDate.IsZero() {
+		q.Set("start_date", input.StartDate.Format(statsDateLayout))
+	}
+	if !input.EndDate.IsZero() {
+		q.Set("end_date", input.EndDate.Format(statsDateLayout))
+	}
+	if input.Timezone != "" {
+		q.Set("timezone", input.Timezone)
+	}
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetAutomationStats)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type LinkStats struct {
+	URL         string `json:"url,omitempty"`
+	URLLocation int64  `json:"url_location,omitempty"`
+	StepID      string `json:"step_id,omitempty"`
+	ABVariation string `json:"ab_variation,omitempty"`
+	ABPhase     string `json:"ab_phase,omitempty"`
+	Clicks      int64  `json:"clicks,omitempty"`
+}
+
+type InputGetAutomationLinkStats struct {
+	// GroupBy splits stats by "step_id".
+	GroupBy   []string
+	StepIDs   []string
+	PageSize  int
+	PageToken string
+}
+
+type OutputGetAutomationLinkStats struct {
+	Results     []*LinkStats `json:"results,omitempty"`
+	TotalClicks int64        `json:"total_clicks,omitempty"`
+	Metadata    _Metadata    `json:"_metadata,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/marketing-campaign-stats/get-an-automation-click-tracking-stats
+func (c *Client) GetAutomationLinkStats(ctx context.Context, id string, input *InputGetAutomationLinkStats) (*OutputGetAutomationLinkStats, error) {
+	u, err := url.Parse(fmt.Sprintf("/marketing/stats/automations/%s/links", id))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if len(input.GroupBy) > 0 {
+		q.Set("group_by", strings.Join(input.GroupBy, ","))
+	}
+	if len(input.StepIDs) > 0 {
+		q.Set("step_ids", strings.Join(input.StepIDs, ","))
+	}
+	if input.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(input.PageSize))
+	}
+	if input.PageToken != "" {
+		q.Set("page_token", input.PageToken)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetAutomationLinkStats)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,226 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllSingleSendStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/stats/singlesends", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "ss-1,ss-2", r.URL.Query().Get("singlesend_ids"))
+		if _, err := fmt.Fprint(w, `{
+			"results": [{"id": "ss-1", "aggregation": "total", "stats": {"delivered": 10, "opens": 4, "unique_opens": 3}}],
+			"_metadata": {"count": 1}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAllSingleSendStats(context.TODO(), &InputGetAllSingleSendStats{
+		SingleSendIDs: []string{"ss-1", "ss-2"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetSingleSendStats{
+		Results: []*SingleSendStats{
+			{
+				ID:          "ss-1",
+				Aggregation: AggregatedByTotal,
+				Stats:       &MarketingStats{Delivered: 10, Opens: 4, UniqueOpens: 3},
+			},
+		},
+		Metadata: _Metadata{Count: 1},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSingleSendStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/stats/singlesends/ss-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "aggregated_by=day&end_date=2026-01-31&group_by=ab_variation&start_date=2026-01-01&timezone=Asia%2FTokyo", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `{
+			"results": [{"id": "ss-1", "ab_variation": "a", "aggregation": "2026-01-01", "stats": {"clicks": 2}}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSingleSendStats(context.TODO(), "ss-1", &InputGetSingleSendStats{
+		AggregatedBy: AggregatedByDay,
+		StartDate:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		Timezone:     "Asia/Tokyo",
+		GroupBy:      []string{"ab_variation"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetSingleSendStats{
+		Results: []*SingleSendStats{
+			{ID: "ss-1", ABVariation: "a", Aggregation: "2026-01-01", Stats: &MarketingStats{Clicks: 2}},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSingleSendStats_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/stats/singlesends/ss-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetSingleSendStats(context.TODO(), "ss-1", &InputGetSingleSendStats{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAllAutomationStats(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/stats/automations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "auto-1,auto-2", r.URL.Query().Get("automation_ids"))
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprintf(w, `{
+				"results": [{"id": "auto-1", "aggregation": "total", "stats": {"delivered": 5}}],
+				"_metadata": {"next": "%s/v3/marketing/stats/automations?page_size=100&page_token=next"}
+			}`, serverURL)
+		case "next":
+			fmt.Fprint(w, `{"results": [{"id": "auto-2", "aggregation": "total", "stats": {"delivered": 7}}], "_metadata": {}}`)
+		}
+	})
+
+	var got []*AutomationStats
+	for s, err := range client.AllAutomationStats(context.TODO(), &InputGetAllAutomationStats{
+		AutomationIDs: []string{"auto-1", "auto-2"},
+	}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		got = append(got, s)
+	}
+
+	want := []*AutomationStats{
+		{ID: "auto-1", Aggregation: AggregatedByTotal, Stats: &MarketingStats{Delivered: 5}},
+		{ID: "auto-2", Aggregation: AggregatedByTotal, Stats: &MarketingStats{Delivered: 7}},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, got)))
+	}
+}
+
+func TestGetAutomationStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/stats/automations/auto-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "aggregated_by=week&end_date=2026-02-28&group_by=step_id&start_date=2026-02-01&step_ids=step-1", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `{
+			"results": [{"id": "auto-1", "step_id": "step-1", "aggregation": "2026-02-01", "stats": {"opens": 3}}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAutomationStats(context.TODO(), "auto-1", &InputGetAutomationStats{
+		GroupBy:      []string{"step_id"},
+		StepIDs:      []string{"step-1"},
+		AggregatedBy: AggregatedByWeek,
+		StartDate:    time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetAutomationStats{
+		Results: []*AutomationStats{
+			{ID: "auto-1", StepID: "step-1", Aggregation: "2026-02-01", Stats: &MarketingStats{Opens: 3}},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetAutomationStats_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/stats/automations/auto-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetAutomationStats(context.TODO(), "auto-1", &InputGetAutomationStats{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetAutomationLinkStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/stats/automations/auto-1/links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "group_by=step_id&page_size=20", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `{
+			"results": [{"url": "https://example.com", "url_location": 1, "step_id": "step-1", "clicks": 4}],
+			"total_clicks": 4,
+			"_metadata": {"count": 1}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAutomationLinkStats(context.TODO(), "auto-1", &InputGetAutomationLinkStats{
+		GroupBy:  []string{"step_id"},
+		PageSize: 20,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetAutomationLinkStats{
+		Results: []*LinkStats{
+			{URL: "https://example.com", URLLocation: 1, StepID: "step-1", Clicks: 4},
+		},
+		TotalClicks: 4,
+		Metadata:    _Metadata{Count: 1},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
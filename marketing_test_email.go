@@ -0,0 +1,47 @@
+package sendgrid
+
+import (
+	"context"
+)
+
+type InputSendTestMarketingEmail struct {
+	// TemplateID is the ID of a dynamic transactional template or a design.
+	TemplateID           string   `json:"template_id"`
+	VersionIDOverride    string   `json:"version_id_override,omitempty"`
+	SenderID             int64    `json:"sender_id,omitempty"`
+	CustomUnsubscribeURL string   `json:"custom_unsubscribe_url,omitempty"`
+	SuppressionGroupID   int64    `json:"suppression_group_id,omitempty"`
+	Emails               []string `json:"emails"`
+	FromAddress          string   `json:"from_address,omitempty"`
+}
+
+// SetDesign sends a test of d.
+func (i *InputSendTestMarketingEmail) SetDesign(d *Design) *InputSendTestMarketingEmail {
+	i.TemplateID = d.ID
+	return i
+}
+
+// SetSender sends the test from a verified sender.
+func (i *InputSendTestMarketingEmail) SetSender(s *VerifiedSender) *InputSendTestMarketingEmail {
+	i.SenderID = s.ID
+	return i
+}
+
+// SetSuppressionGroup sets the unsubscribe group of the test.
+func (i *InputSendTestMarketingEmail) SetSuppressionGroup(g *SuppressionGroup) *InputSendTestMarketingEmail {
+	i.SuppressionGroupID = g.ID
+	return i
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/send-test-email/send-test-marketing-email
+func (c *Client) SendTestMarketingEmail(ctx context.Context, input *InputSendTestMarketingEmail) error {
+	req, err := c.NewRequest("POST", "/marketing/test/send_email", input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package sendgrid
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendTestMarketingEmail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/test/send_email", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{
+			"template_id": "design-1",
+			"sender_id": 34,
+			"suppression_group_id": 12,
+			"emails": ["seed@example.com"]
+		}`, string(body))
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	input := &InputSendTestMarketingEmail{Emails: []string{"seed@example.com"}}
+	input.SetDesign(&Design{ID: "design-1"}).
+		SetSender(&VerifiedSender{ID: 34}).
+		SetSuppressionGroup(&SuppressionGroup{ID: 12})
+
+	if err := client.SendTestMarketingEmail(context.TODO(), input); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestSendTestMarketingEmail_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/marketing/test/send_email", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	if err := client.SendTestMarketingEmail(context.TODO(), &InputSendTestMarketingEmail{}); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
@@ -0,0 +1,85 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+// Doer sends an HTTP request and returns its response.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts an ordinary function to the Doer interface.
+type DoerFunc func(*http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer used by Client.Do.
+type Middleware func(next Doer) Doer
+
+// OptionMiddleware appends middleware to the client. The first middleware is
+// the outermost one. Each middleware is called once per Client.Do call with
+// the final response after retries, and can read the operation name of the
+// request with OperationFromContext(req.Context()).
+func OptionMiddleware(m ...Middleware) func(*Client) {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, m...)
+	}
+}
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying the operation name reported to
+// middleware, overriding the name of the calling Client method.
+func WithOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, name)
+}
+
+// OperationFromContext returns the logical operation name, e.g. "CreateTemplate",
+// of a request sent by Client.Do.
+func OperationFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
+// clientMethodPrefix is the qualified name prefix of *Client methods, e.g.
+// "github.com/kenzo0107/sendgrid.(*Client).".
+var clientMethodPrefix = strings.TrimSuffix(runtime.FuncForPC(reflect.ValueOf((*Client).Debug).Pointer()).Name(), "Debug")
+
+// callerOperation returns the name of the nearest exported Client method on
+// the call stack above Client.Do.
+func callerOperation() string {
+	pc := make([]uintptr, 8)
+	n := runtime.Callers(3, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		if name, ok := strings.CutPrefix(frame.Function, clientMethodPrefix); ok && !strings.Contains(name, ".") {
+			if r := []rune(name); len(r) > 0 && unicode.IsUpper(r[0]) {
+				return name
+			}
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// chain wraps d with the client's middleware.
+func (c *Client) chain(d Doer) Doer {
+	if c.slog != nil {
+		d = c.logging(d)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	return d
+}
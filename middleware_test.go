@@ -0,0 +1,96 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionMiddleware(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "audit", r.Header.Get("X-Audit"))
+		fmt.Fprint(w, `{"id": "d-12345", "name": "dummy"}`)
+	})
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+":"+OperationFromContext(req.Context()))
+				req.Header.Set("X-Audit", "audit")
+				resp, err := next.Do(req)
+				calls = append(calls, fmt.Sprintf("%s:%d", name, resp.StatusCode))
+				return resp, err
+			})
+		}
+	}
+	OptionMiddleware(record("outer"), record("inner"))(client)
+
+	if _, err := client.CreateTemplate(context.TODO(), &InputCreateTemplate{Name: "dummy"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Equal(t, []string{
+		"outer:CreateTemplate",
+		"inner:CreateTemplate",
+		"inner:200",
+		"outer:200",
+	}, calls)
+}
+
+func TestOptionMiddleware_Operation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/designs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"result": [{"id": "dummy"}], "_metadata": {"count": 1}}`)
+	})
+
+	var operations []string
+	OptionMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			operations = append(operations, OperationFromContext(req.Context()))
+			return next.Do(req)
+		})
+	})(client)
+
+	ctx := context.TODO()
+	if _, err := client.GetDesigns(ctx); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for _, err := range client.AllDesigns(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	req, err := client.NewRequest("GET", "/designs", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := client.Do(WithOperation(ctx, "ListDesigns"), req, nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Equal(t, []string{"GetDesigns", "GetDesigns", "ListDesigns"}, operations)
+}
+
+func TestOptionMiddleware_ShortCircuit(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	OptionMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("blocked %s", OperationFromContext(req.Context()))
+		})
+	})(client)
+
+	err := client.DeleteTemplate(context.TODO(), "d-12345")
+	assert.EqualError(t, err, "blocked DeleteTemplate")
+}
@@ -1,8 +1,10 @@
 package sendgrid
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httputil"
 	"strconv"
@@ -32,6 +34,7 @@ type ErrorsResponse struct {
 }
 
 type RateLimitedError struct {
+	// RetryAfter is zero when the response has no X-RateLimit-Reset header.
 	RetryAfter time.Duration
 }
 
@@ -43,6 +46,7 @@ func (e *RateLimitedError) Error() string {
 type Error struct {
 	Field   *string `json:"field,omitempty"`
 	Message *string `json:"message,omitempty"`
+	ID      *string `json:"error_id,omitempty"`
 }
 
 // Errs : error list
@@ -56,7 +60,9 @@ func (t ErrorsResponse) Errs() error {
 			msg.WriteString(", ")
 		}
 		msg.WriteString("message: ")
-		msg.WriteString(*err.Message)
+		if err.Message != nil {
+			msg.WriteString(*err.Message)
+		}
 		s = append(s, msg.String())
 	}
 
@@ -67,26 +73,69 @@ func (t ErrorsResponse) Errs() error {
 	return errors.New(strings.Join(s, ", "))
 }
 
-// StatusCodeError represents an http response error.
-// type httpStatusCode interface { HTTPStatusCode() int } to handle it.
-type statusCodeError struct {
-	Code   int
-	Status string
+// APIError represents a non-2xx response returned by the SendGrid API.
+// Use errors.As to retrieve it from an error returned by the client.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	Path       string
+	Errors     []*Error
+	Message    string
+	Body       []byte
+	RequestID  string
 }
 
-func (t statusCodeError) Error() string {
+func (t *APIError) Error() string {
+	if len(t.Errors) > 0 {
+		return ErrorsResponse{Errors: t.Errors}.Errs().Error()
+	}
+	if t.Message != "" {
+		return t.Message
+	}
 	return fmt.Sprintf("sendgrid server error: %s", t.Status)
 }
 
-func (t statusCodeError) HTTPStatusCode() int {
-	return t.Code
+// HTTPStatusCode returns the http status code of the response.
+func (t *APIError) HTTPStatusCode() int {
+	return t.StatusCode
+}
+
+func hasStatusCode(err error, code int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == code
+}
+
+// IsBadRequest reports whether err is an *APIError with status code 400.
+func IsBadRequest(err error) bool { return hasStatusCode(err, http.StatusBadRequest) }
+
+// IsUnauthorized reports whether err is an *APIError with status code 401.
+func IsUnauthorized(err error) bool { return hasStatusCode(err, http.StatusUnauthorized) }
+
+// IsForbidden reports whether err is an *APIError with status code 403.
+func IsForbidden(err error) bool { return hasStatusCode(err, http.StatusForbidden) }
+
+// IsNotFound reports whether err is an *APIError with status code 404.
+func IsNotFound(err error) bool { return hasStatusCode(err, http.StatusNotFound) }
+
+// IsConflict reports whether err is an *APIError with status code 409.
+func IsConflict(err error) bool { return hasStatusCode(err, http.StatusConflict) }
+
+// IsRateLimited reports whether err is a *RateLimitedError.
+func IsRateLimited(err error) bool {
+	var rateLimitedErr *RateLimitedError
+	return errors.As(err, &rateLimitedErr)
 }
 
+// maxErrorBodySize caps how much of an error response body is buffered.
+const maxErrorBodySize = 1 << 20
+
 func checkStatusCode(resp *http.Response, d debug) error {
 	if resp.StatusCode == http.StatusTooManyRequests {
+		// without X-RateLimit-Reset, the time to wait is unknown
 		xRateLimitReset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
 		if err != nil {
-			return err
+			return &RateLimitedError{}
 		}
 
 		retryAfter := time.Until(time.Unix(xRateLimitReset, 0))
@@ -98,43 +147,98 @@ func checkStatusCode(resp *http.Response, d debug) error {
 		return nil
 	}
 
-	if err := logResponse(resp, d); err != nil {
+	// the body is read exactly once, every consumer below works on the buffer
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil {
 		return err
 	}
 
-	// {"errors": [{"field": "field name", "message": "error message"}]}
-	errorsResponse := new(ErrorsResponse)
-	if err := newJSONParser(errorsResponse)(resp); err == nil {
-		if errorsResponse.Errs() != nil {
-			return errorsResponse.Errs()
+	if err := logResponse(resp, body, d); err != nil {
+		return err
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       body,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Path = resp.Request.URL.Path
+	}
+
+	for _, parse := range errorParsers {
+		if parse(body, apiErr) {
+			break
 		}
 	}
 
+	return apiErr
+}
+
+// errorParsers try each error shape returned by SendGrid in turn. A parser
+// returns true when it recognised the body and filled in apiErr.
+var errorParsers = []func(body []byte, apiErr *APIError) bool{
+	// {"errors": [{"field": "field name", "message": "error message"}]}
+	func(body []byte, apiErr *APIError) bool {
+		errorsResponse := new(ErrorsResponse)
+		if err := newJSONParser(errorsResponse)(bytes.NewReader(body)); err != nil || len(errorsResponse.Errors) == 0 {
+			return false
+		}
+		apiErr.Errors = errorsResponse.Errors
+		return true
+	},
+	// {"errors": ["error message"]}
+	func(body []byte, apiErr *APIError) bool {
+		var v struct {
+			Errors []string `json:"errors"`
+		}
+		if err := newJSONParser(&v)(bytes.NewReader(body)); err != nil || len(v.Errors) == 0 {
+			return false
+		}
+		for _, msg := range v.Errors {
+			apiErr.Errors = append(apiErr.Errors, &Error{Message: String(msg)})
+		}
+		return true
+	},
 	// {"error": "error message"}
-	errorResponse := new(ErrorResponse)
-	if err := newJSONParser(errorResponse)(resp); err == nil {
-		if errorResponse.Err() != nil {
-			return errorResponse.Err()
+	func(body []byte, apiErr *APIError) bool {
+		errorResponse := new(ErrorResponse)
+		if err := newJSONParser(errorResponse)(bytes.NewReader(body)); err != nil || errorResponse.Error == "" {
+			return false
 		}
-	}
-	return statusCodeError{Code: resp.StatusCode, Status: resp.Status}
+		apiErr.Message = errorResponse.Error
+		return true
+	},
+	// {"message": "error message"}
+	func(body []byte, apiErr *APIError) bool {
+		var v struct {
+			Message string `json:"message"`
+		}
+		if err := newJSONParser(&v)(bytes.NewReader(body)); err != nil || v.Message == "" {
+			return false
+		}
+		apiErr.Message = v.Message
+		return true
+	},
 }
 
-type responseParser func(*http.Response) error
+type responseParser func(io.Reader) error
 
 func newJSONParser(dst interface{}) responseParser {
-	return func(resp *http.Response) error {
-		return json.NewDecoder(resp.Body).Decode(dst)
+	return func(r io.Reader) error {
+		return json.NewDecoder(r).Decode(dst)
 	}
 }
 
-func logResponse(resp *http.Response, d debug) error {
+func logResponse(resp *http.Response, body []byte, d debug) error {
 	if d.Debug() {
-		text, err := httputil.DumpResponse(resp, true)
+		header, err := httputil.DumpResponse(resp, false)
 		if err != nil {
 			return err
 		}
-		d.Debugln(string(text))
+		d.Debugln(string(header) + redactBody(body))
 	}
 
 	return nil
@@ -1,12 +1,17 @@
 package sendgrid
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestErrorResponse(t *testing.T) {
@@ -28,11 +33,13 @@ func TestErrorResponse(t *testing.T) {
 	client.Debugf("%s", "test")
 	client.Debugln("test")
 
-	if _, err := client.UpdateTemplateVersion(context.TODO(), "d-12345abcde", "aaaaaa-bbbb-0000-0000-aaaaaaaaa", &InputUpdateTemplateVersion{
+	_, err := client.UpdateTemplateVersion(context.TODO(), "d-12345abcde", "aaaaaa-bbbb-0000-0000-aaaaaaaaa", &InputUpdateTemplateVersion{
 		Editor: "code",
-	}); err == nil {
+	})
+	if err == nil {
 		t.Fatal("expected an error but got none", err)
 	}
+	assert.Equal(t, "You cannot switch editors once a dynamic template version has been created.", err.Error())
 }
 
 func TestErrorsResponse(t *testing.T) {
@@ -59,6 +66,26 @@ func TestErrorsResponse(t *testing.T) {
 	}
 }
 
+func TestErrorResponse_LargeBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"api_key": "secret", "padding": "%s"}`, strings.Repeat("x", maxErrorBodySize))
+	})
+
+	var buf bytes.Buffer
+	client.debug = true
+	client.log = log.New(&buf, "sendgrid: ", 0)
+
+	if _, err := client.GetAPIKeys(context.TODO()); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+	assert.NotContains(t, buf.String(), "secret")
+	assert.Contains(t, buf.String(), redacted)
+}
+
 func TestStatusUnAuthorized(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -73,3 +100,133 @@ func TestStatusUnAuthorized(t *testing.T) {
 		t.Fatal("expected an error but got none", err)
 	}
 }
+
+func TestAPIError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/teammates/dummy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("X-Request-Id", "request-id")
+		w.WriteHeader(http.StatusNotFound)
+		if _, err := fmt.Fprint(w, `{"errors":[{"field": "username", "message": "teammate does not exist", "error_id": "not_found"}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.GetTeammate(context.TODO(), "dummy")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError but got %v", err)
+	}
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "GET", apiErr.Method)
+	assert.Equal(t, "/v3/teammates/dummy", apiErr.Path)
+	assert.Equal(t, "request-id", apiErr.RequestID)
+	assert.Equal(t, []*Error{{Field: String("username"), Message: String("teammate does not exist"), ID: String("not_found")}}, apiErr.Errors)
+	assert.Equal(t, "field: username, message: teammate does not exist", err.Error())
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsConflict(err))
+}
+
+func TestAPIError_StatusCode(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	_, err := client.CreateSubuser(context.TODO(), &InputCreateSubuser{Username: "dummy"})
+	assert.True(t, IsConflict(err))
+	assert.False(t, IsBadRequest(err))
+	assert.False(t, IsUnauthorized(err))
+	assert.False(t, IsForbidden(err))
+	assert.Equal(t, "sendgrid server error: 409 Conflict", err.Error())
+}
+
+func TestRateLimitedError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.CreateSubuser(context.TODO(), &InputCreateSubuser{Username: "dummy"})
+	var rateLimitedErr *RateLimitedError
+	if !errors.As(err, &rateLimitedErr) {
+		t.Fatalf("expected *RateLimitedError but got %#v", err)
+	}
+	assert.Zero(t, rateLimitedErr.RetryAfter)
+	assert.True(t, IsRateLimited(err))
+	assert.False(t, IsRateLimited(errors.New("dummy")))
+}
+
+func TestAPIError_Shapes(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		message string
+		// logged defaults to body
+		logged string
+	}{
+		{
+			name:    "errors",
+			body:    `{"errors":[{"field": "name", "message": "is required"}]}`,
+			message: "field: name, message: is required",
+		},
+		{
+			name:    "errors as strings",
+			body:    `{"errors":["is required", "is invalid"]}`,
+			message: "message: is required, message: is invalid",
+		},
+		{
+			name:    "error",
+			body:    `{"error": "is required"}`,
+			message: "is required",
+		},
+		{
+			name:    "message",
+			body:    `{"message": "is required"}`,
+			message: "is required",
+		},
+		{
+			name:    "not json",
+			body:    `<html>bad gateway</html>`,
+			message: "sendgrid server error: 400 Bad Request",
+			logged:  "[REDACTED] (24 bytes)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/teammates/dummy", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				if _, err := fmt.Fprint(w, tt.body); err != nil {
+					t.Fatal(err)
+				}
+			})
+
+			var buf bytes.Buffer
+			client.debug = true
+			client.log = log.New(&buf, "", 0)
+
+			_, err := client.GetTeammate(context.TODO(), "dummy")
+			if err == nil {
+				t.Fatal("expected an error but got none")
+			}
+			assert.Equal(t, tt.message, err.Error())
+			assert.True(t, IsBadRequest(err))
+			logged := tt.body
+			if tt.logged != "" {
+				logged = tt.logged
+			}
+			assert.Contains(t, buf.String(), logged)
+		})
+	}
+}
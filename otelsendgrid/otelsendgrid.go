@@ -0,0 +1,177 @@
+// Package otelsendgrid instruments github.com/kenzo0107/sendgrid clients with
+// OpenTelemetry tracing and metrics.
+//
+//	c := sendgrid.New(apiKey, sendgrid.OptionMiddleware(otelsendgrid.Middleware()))
+//
+// Every API operation, retries included, is recorded as one client span named
+// after the operation, e.g. "CreateTemplate", and as one measurement of the
+// sendgrid.client.duration histogram. Failed operations also increment the
+// sendgrid.client.errors counter. The operation name stands in for the path
+// template in both: raw paths are not recorded, as they carry email addresses
+// and other identifiers. The subuser is only recorded on spans, to keep the
+// cardinality of the metrics bounded.
+//
+// otelsendgrid is a module of its own, so the client itself does not depend on
+// OpenTelemetry.
+package otelsendgrid
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kenzo0107/sendgrid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name of the tracer and meter.
+const ScopeName = "github.com/kenzo0107/sendgrid/otelsendgrid"
+
+// attribute keys specific to SendGrid
+const (
+	OperationKey          = attribute.Key("sendgrid.operation")
+	SubuserKey            = attribute.Key("sendgrid.subuser")
+	RateLimitLimitKey     = attribute.Key("sendgrid.ratelimit.limit")
+	RateLimitRemainingKey = attribute.Key("sendgrid.ratelimit.remaining")
+	RateLimitResetKey     = attribute.Key("sendgrid.ratelimit.reset")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider. The global one is used by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider. The global one is used by default.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+type instrumentation struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// Middleware returns a sendgrid.Middleware recording spans and metrics.
+func Middleware(opts ...Option) sendgrid.Middleware {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+	if c.meterProvider == nil {
+		c.meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := c.meterProvider.Meter(ScopeName)
+	i := &instrumentation{tracer: c.tracerProvider.Tracer(ScopeName)}
+
+	var err error
+	i.duration, err = meter.Float64Histogram("sendgrid.client.duration",
+		metric.WithDescription("Duration of SendGrid API operations, retries included."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	i.errors, err = meter.Int64Counter("sendgrid.client.errors",
+		metric.WithDescription("Number of SendGrid API operations that failed."),
+		metric.WithUnit("{error}"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return i.middleware
+}
+
+func (i *instrumentation) middleware(next sendgrid.Doer) sendgrid.Doer {
+	return sendgrid.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		ctx := req.Context()
+		operation := sendgrid.OperationFromContext(ctx)
+
+		name := operation
+		if name == "" {
+			name = req.Method
+		}
+
+		attrs := []attribute.KeyValue{
+			OperationKey.String(operation),
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+		}
+
+		ctx, span := i.tracer.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+		if subuser := req.Header.Get("On-Behalf-Of"); subuser != "" {
+			span.SetAttributes(SubuserKey.String(subuser))
+		}
+		defer span.End()
+
+		start := time.Now()
+		resp, err := next.Do(req.WithContext(ctx))
+		elapsed := time.Since(start).Seconds()
+
+		errorType := ""
+		switch {
+		case err != nil:
+			errorType = "request"
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		default:
+			status := attribute.Int("http.response.status_code", resp.StatusCode)
+			attrs = append(attrs, status)
+			span.SetAttributes(status)
+			span.SetAttributes(rateLimitAttributes(resp.Header)...)
+			if resp.StatusCode >= http.StatusBadRequest {
+				errorType = strconv.Itoa(resp.StatusCode)
+				span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			}
+		}
+
+		if errorType != "" {
+			attrs = append(attrs, attribute.String("error.type", errorType))
+			i.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		i.duration.Record(ctx, elapsed, metric.WithAttributes(attrs...))
+
+		return resp, err
+	})
+}
+
+func rateLimitAttributes(h http.Header) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	for _, kv := range []struct {
+		key    attribute.Key
+		header string
+	}{
+		{RateLimitLimitKey, "X-RateLimit-Limit"},
+		{RateLimitRemainingKey, "X-RateLimit-Remaining"},
+		{RateLimitResetKey, "X-RateLimit-Reset"},
+	} {
+		if v, err := strconv.ParseInt(h.Get(kv.header), 10, 64); err == nil {
+			attrs = append(attrs, kv.key.Int64(v))
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,116 @@
+package otelsendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/sendgrid/otelsendgrid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setup(t *testing.T, handler http.HandlerFunc) (*sendgrid.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	c := sendgrid.New("test",
+		sendgrid.OptionBaseURL(server.URL),
+		sendgrid.OptionSubuser("dummy"),
+		sendgrid.OptionMiddleware(otelsendgrid.Middleware(
+			otelsendgrid.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+			otelsendgrid.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		)),
+	)
+	return c, spans, reader
+}
+
+func TestMiddleware(t *testing.T) {
+	c, spans, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "599")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		fmt.Fprint(w, `{"id": "d-12345"}`)
+	})
+
+	_, err := c.GetTemplate(context.TODO(), "d-12345")
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	span := ended[0]
+	assert.Equal(t, "GetTemplate", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+	assert.Subset(t, span.Attributes(), []attribute.KeyValue{
+		otelsendgrid.OperationKey.String("GetTemplate"),
+		otelsendgrid.SubuserKey.String("dummy"),
+		otelsendgrid.RateLimitLimitKey.Int64(600),
+		otelsendgrid.RateLimitRemainingKey.Int64(599),
+		otelsendgrid.RateLimitResetKey.Int64(1700000000),
+		attribute.String("http.request.method", "GET"),
+		attribute.Int("http.response.status_code", 200),
+	})
+	for _, kv := range span.Attributes() {
+		assert.NotEqual(t, attribute.Key("url.path"), kv.Key, "raw paths must not be recorded")
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.TODO(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	duration := rm.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "sendgrid.client.duration", duration.Name)
+	points := duration.Data.(metricdata.Histogram[float64]).DataPoints
+	require.Len(t, points, 1)
+	assert.Equal(t, uint64(1), points[0].Count)
+	assert.False(t, points[0].Attributes.HasValue(otelsendgrid.SubuserKey), "subusers must not be metric attributes")
+	v, ok := points[0].Attributes.Value(otelsendgrid.OperationKey)
+	assert.True(t, ok)
+	assert.Equal(t, "GetTemplate", v.AsString())
+}
+
+func TestMiddleware_Failed(t *testing.T) {
+	c, spans, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := c.DeleteTemplate(context.TODO(), "d-12345")
+	require.Error(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, "DeleteTemplate", ended[0].Name())
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.TODO(), &rm))
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		if m.Name != "sendgrid.client.errors" {
+			continue
+		}
+		points := m.Data.(metricdata.Sum[int64]).DataPoints
+		require.Len(t, points, 1)
+		assert.Equal(t, int64(1), points[0].Value)
+		v, ok := points[0].Attributes.Value("error.type")
+		assert.True(t, ok)
+		assert.Equal(t, "404", v.AsString())
+		return
+	}
+	t.Fatal("sendgrid.client.errors was not recorded")
+}
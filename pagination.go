@@ -0,0 +1,56 @@
+package sendgrid
+
+import (
+	"context"
+	"iter"
+	"net/url"
+)
+
+// defaultPageSize is used by the All* iterators when the input leaves the page size unset.
+const defaultPageSize = 100
+
+// pageFetcher fetches one page of items and reports whether another page follows.
+// It is expected to advance its own cursor between calls.
+type pageFetcher[T any] func(ctx context.Context) (items []T, more bool, err error)
+
+// paginate turns pageFetchers into an iterator that walks every page.
+// newFetcher is called at the start of every range over the iterator, so each
+// pass starts from the first page with its own cursor.
+// Iteration stops at the first error, which is yielded with the zero value of T.
+func paginate[T any](ctx context.Context, newFetcher func() pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		fetch := newFetcher()
+		for {
+			items, more, err := fetch(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !more || len(items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// pageToken extracts the page_token query parameter from a _metadata.next URL.
+func pageToken(next string) string {
+	if next == "" {
+		return ""
+	}
+
+	u, err := url.Parse(next)
+	if err != nil {
+		return ""
+	}
+
+	return u.Query().Get("page_token")
+}
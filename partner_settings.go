@@ -0,0 +1,100 @@
+package sendgrid
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type InputGetPartnerSettings struct {
+	Limit  int
+	Offset int
+}
+
+type OutputGetPartnerSettings struct {
+	Result []*ResultGetPartnerSettings `json:"result,omitempty"`
+}
+
+type ResultGetPartnerSettings struct {
+	Name        string `json:"name,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-partner/returns-a-list-of-all-partner-settings
+func (c *Client) GetPartnerSettings(ctx context.Context, input *InputGetPartnerSettings) (*OutputGetPartnerSettings, error) {
+	u, err := url.Parse("/partner_settings")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetPartnerSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetNewRelicSettings struct {
+	Enabled                 bool   `json:"enabled,omitempty"`
+	EnableSubuserStatistics bool   `json:"enable_subuser_statistics,omitempty"`
+	LicenseKey              string `json:"license_key,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-partner/returns-all-new-relic-partner-settings
+func (c *Client) GetNewRelicSettings(ctx context.Context) (*OutputGetNewRelicSettings, error) {
+	req, err := c.NewRequest("GET", "/partner_settings/new_relic", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetNewRelicSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputUpdateNewRelicSettings struct {
+	Enabled                 bool   `json:"enabled"`
+	EnableSubuserStatistics bool   `json:"enable_subuser_statistics"`
+	LicenseKey              string `json:"license_key,omitempty"`
+}
+
+type OutputUpdateNewRelicSettings struct {
+	Enabled                 bool   `json:"enabled,omitempty"`
+	EnableSubuserStatistics bool   `json:"enable_subuser_statistics,omitempty"`
+	LicenseKey              string `json:"license_key,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-partner/updates-new-relic-partner-settings
+func (c *Client) UpdateNewRelicSettings(ctx context.Context, input *InputUpdateNewRelicSettings) (*OutputUpdateNewRelicSettings, error) {
+	req, err := c.NewRequest("PATCH", "/partner_settings/new_relic", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateNewRelicSettings)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
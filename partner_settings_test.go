@@ -0,0 +1,137 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPartnerSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/partner_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{
+			"result":[
+				{
+					"name":"new_relic",
+					"title":"New Relic",
+					"description":"Send your SendGrid stats to New Relic.",
+					"enabled":true
+				}
+			]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetPartnerSettings(context.TODO(), &InputGetPartnerSettings{})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetPartnerSettings{
+		Result: []*ResultGetPartnerSettings{
+			{
+				Name:        "new_relic",
+				Title:       "New Relic",
+				Description: "Send your SendGrid stats to New Relic.",
+				Enabled:     true,
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetPartnerSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/partner_settings", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetPartnerSettings(context.TODO(), &InputGetPartnerSettings{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetNewRelicSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/partner_settings/new_relic", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"enabled": true, "enable_subuser_statistics": true, "license_key": "dummy"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetNewRelicSettings(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetNewRelicSettings{Enabled: true, EnableSubuserStatistics: true, LicenseKey: "dummy"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateNewRelicSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/partner_settings/new_relic", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"enabled": true, "enable_subuser_statistics": false, "license_key": "dummy"}`, string(body))
+		if _, err := fmt.Fprint(w, `{"enabled": true, "enable_subuser_statistics": false, "license_key": "dummy"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.UpdateNewRelicSettings(context.TODO(), &InputUpdateNewRelicSettings{
+		Enabled:    true,
+		LicenseKey: "dummy",
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputUpdateNewRelicSettings{Enabled: true, LicenseKey: "dummy"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestUpdateNewRelicSettings_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/partner_settings/new_relic", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.UpdateNewRelicSettings(context.TODO(), &InputUpdateNewRelicSettings{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
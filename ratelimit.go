@@ -0,0 +1,132 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitMode selects what the client-side rate limiter does when the
+// rate limit of an endpoint is known to be exhausted.
+type RateLimitMode int
+
+const (
+	// RateLimitFailFast makes Client.Do return *RateLimitedError without
+	// sending the request.
+	RateLimitFailFast RateLimitMode = iota + 1
+	// RateLimitWait makes Client.Do wait until the rate limit resets, or
+	// until its context is done.
+	RateLimitWait
+)
+
+// OptionRateLimiter enables the client-side rate limiter. It remembers the
+// X-RateLimit-Remaining and X-RateLimit-Reset headers of every response per
+// subuser and endpoint, and stops requests that would be rejected with 429.
+func OptionRateLimiter(mode RateLimitMode) func(*Client) {
+	return func(c *Client) {
+		c.limiter = &rateLimiter{
+			mode:    mode,
+			buckets: map[string]*rateLimitBucket{},
+			now:     time.Now,
+		}
+	}
+}
+
+type rateLimiter struct {
+	mode RateLimitMode
+	now  func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+}
+
+type rateLimitBucket struct {
+	remaining int
+	reset     time.Time
+}
+
+// rateLimitKey identifies the rate limit bucket of req. SendGrid limits each
+// endpoint separately for every account, so the subuser is part of the key.
+func rateLimitKey(req *http.Request) string {
+	endpoint := OperationFromContext(req.Context())
+	if endpoint == "" {
+		endpoint = req.URL.Path
+	}
+	return req.Header.Get("On-Behalf-Of") + " " + req.Method + " " + endpoint
+}
+
+// wait reserves a request from the bucket of key, waiting for the bucket to
+// reset or failing fast when it is exhausted.
+func (l *rateLimiter) wait(ctx context.Context, key string) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		d := l.reserve(key)
+		if d <= 0 {
+			return nil
+		}
+		if l.mode != RateLimitWait {
+			return &RateLimitedError{RetryAfter: d}
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes one request from the bucket of key, or returns how long
+// remains until the bucket resets when it is exhausted.
+func (l *rateLimiter) reserve(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		return 0
+	}
+
+	now := l.now()
+	if !now.Before(b.reset) {
+		// the window has passed, the next response tells the new state
+		delete(l.buckets, key)
+		return 0
+	}
+	if b.remaining <= 0 {
+		return b.reset.Sub(now)
+	}
+	b.remaining--
+	return 0
+}
+
+// update records the rate limit headers of resp for key.
+func (l *rateLimiter) update(key string, resp *http.Response) {
+	if l == nil || resp == nil {
+		return
+	}
+
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		remaining = 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.buckets[key] = &rateLimitBucket{remaining: remaining, reset: time.Unix(reset, 0)}
+}
@@ -0,0 +1,94 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimitHandler(hits *int, remaining int, reset time.Time) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*hits++
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		fmt.Fprint(w, `{"result": []}`)
+	}
+}
+
+func TestOptionRateLimiter_FailFast(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var keys, teammates int
+	mux.HandleFunc("/api_keys", rateLimitHandler(&keys, 1, time.Now().Add(time.Hour)))
+	mux.HandleFunc("/teammates", rateLimitHandler(&teammates, 0, time.Now().Add(time.Hour)))
+	OptionRateLimiter(RateLimitFailFast)(client)
+
+	ctx := context.TODO()
+
+	// the first response tells that one request remains
+	_, err := client.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	_, err = client.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, keys)
+
+	// each endpoint has its own bucket
+	_, err = client.GetTeammates(ctx, &InputGetTeammates{})
+	require.NoError(t, err)
+	_, err = client.GetTeammates(ctx, &InputGetTeammates{})
+	var rateLimitedErr *RateLimitedError
+	require.True(t, errors.As(err, &rateLimitedErr), "expected *RateLimitedError but got %v", err)
+	assert.Greater(t, rateLimitedErr.RetryAfter, 59*time.Minute)
+	assert.Equal(t, 1, teammates)
+}
+
+func TestOptionRateLimiter_Subuser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var hits int
+	mux.HandleFunc("/api_keys", rateLimitHandler(&hits, 0, time.Now().Add(time.Hour)))
+	OptionRateLimiter(RateLimitFailFast)(client)
+
+	ctx := context.TODO()
+	_, err := client.GetAPIKeys(ctx)
+	require.NoError(t, err)
+
+	OptionSubuser("other")(client)
+	_, err = client.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, hits)
+}
+
+func TestOptionRateLimiter_Wait(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var hits int
+	mux.HandleFunc("/api_keys", rateLimitHandler(&hits, 0, time.Now().Add(time.Hour)))
+	OptionRateLimiter(RateLimitWait)(client)
+
+	_, err := client.GetAPIKeys(context.TODO())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.GetAPIKeys(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, hits)
+
+	// once the window has passed the request is sent again
+	client.limiter.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, err = client.GetAPIKeys(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, 2, hits)
+}
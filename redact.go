@@ -0,0 +1,133 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redacted replaces sensitive values in logs.
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are masked in logged request and response headers.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveFields are masked at any depth of logged JSON bodies and in
+// logged query strings, which include the parameters of signed URLs.
+var sensitiveFields = map[string]bool{
+	"api_key":              true,
+	"authorization_token":  true,
+	"license_key":          true,
+	"new_password":         true,
+	"oauth_client_secret":  true,
+	"old_password":         true,
+	"password":             true,
+	"presigned_url":        true,
+	"private_key":          true,
+	"public_certificate":   true,
+	"secret":               true,
+	"signature":            true,
+	"signup_session_token": true,
+	"token":                true,
+	"upload_uri":           true,
+	"urls":                 true,
+	"x-amz-credential":     true,
+	"x-amz-security-token": true,
+	"x-amz-signature":      true,
+	"x509certificate":      true,
+}
+
+// redactHeader returns a copy of h with sensitive headers masked.
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		if _, ok := h[k]; ok {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
+
+// redactQuery returns the raw query with the values of sensitive parameters
+// masked. Queries without sensitive parameters are returned unchanged.
+func redactQuery(rawQuery string) string {
+	// a malformed query is still parsed as far as possible
+	q, _ := url.ParseQuery(rawQuery)
+
+	found := false
+	for k := range q {
+		if sensitiveFields[strings.ToLower(k)] {
+			q[k] = []string{redacted}
+			found = true
+		}
+	}
+	if !found {
+		return rawQuery
+	}
+	return q.Encode()
+}
+
+// redactBody returns body with the values of sensitive JSON fields masked.
+// Bodies without sensitive fields are returned unchanged. Bodies that are not
+// JSON, including truncated ones, cannot be checked and are replaced by their
+// length.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("%s (%d bytes)", redacted, len(body))
+	}
+
+	if !redactValue(v) {
+		return string(body)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+// redactValue masks sensitive fields of v in place and reports whether any was found.
+func redactValue(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if sensitiveFields[strings.ToLower(k)] {
+				v[k] = redacted
+				found = true
+				continue
+			}
+			if redactValue(e) {
+				found = true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if redactValue(e) {
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+// truncate cuts s to at most n bytes. It is applied after redaction, as a
+// truncated JSON body can no longer be redacted.
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
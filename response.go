@@ -0,0 +1,58 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response is the metadata of the HTTP response of an API call.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	// RequestID is the X-Request-Id header SendGrid support asks for.
+	RequestID string
+	RateLimit RateLimit
+}
+
+// RateLimit is the rate limit state reported by the X-RateLimit-* headers.
+// Fields are zero when the headers are absent.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+type responseKey struct{}
+
+// WithResponse returns a copy of ctx that makes Client.Do store the metadata
+// of the response in resp, including when the call fails with an API error.
+// Methods that send several requests, such as the All* iterators, leave the
+// metadata of the last response.
+//
+//	var resp sendgrid.Response
+//	subusers, err := c.GetSubusers(sendgrid.WithResponse(ctx, &resp), input)
+//	log.Println(resp.RequestID, resp.RateLimit.Remaining)
+func WithResponse(ctx context.Context, resp *Response) context.Context {
+	return context.WithValue(ctx, responseKey{}, resp)
+}
+
+// captureResponse fills the Response registered with WithResponse, if any.
+func captureResponse(ctx context.Context, resp *http.Response) {
+	r, ok := ctx.Value(responseKey{}).(*Response)
+	if !ok || r == nil {
+		return
+	}
+
+	*r = Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+	r.RateLimit.Limit, _ = strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	r.RateLimit.Remaining, _ = strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		r.RateLimit.Reset = time.Unix(reset, 0)
+	}
+}
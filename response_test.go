@@ -0,0 +1,52 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "dummy-request-id")
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "599")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		fmt.Fprint(w, `[{"id": 1, "username": "dummy"}]`)
+	})
+
+	var resp Response
+	_, err := client.GetSubusers(WithResponse(context.TODO(), &resp), &InputGetSubusers{})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "dummy-request-id", resp.RequestID)
+	assert.Equal(t, "600", resp.Header.Get("X-RateLimit-Limit"))
+	assert.Equal(t, RateLimit{Limit: 600, Remaining: 599, Reset: time.Unix(1700000000, 0)}, resp.RateLimit)
+}
+
+func TestWithResponse_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "dummy-request-id")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	var resp Response
+	_, err := client.GetSubusers(WithResponse(context.TODO(), &resp), &InputGetSubusers{})
+	require.Error(t, err)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, "dummy-request-id", resp.RequestID)
+	assert.Equal(t, RateLimit{}, resp.RateLimit)
+}
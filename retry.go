@@ -0,0 +1,157 @@
+package sendgrid
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy configures how Client.Do retries failed requests.
+//
+// Requests that were rate limited (429) are always retried, waiting until
+// X-RateLimit-Reset when the header is present. Idempotent requests
+// (GET, HEAD, OPTIONS, PUT, DELETE) are also retried on 502, 503 and 504
+// responses and on connection resets. Other waits use exponential backoff
+// with full jitter between MinBackoff and MaxBackoff.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	MaxAttempts int
+	// MinBackoff is the base delay of the exponential backoff.
+	MinBackoff time.Duration
+	// MaxBackoff caps every delay, including the one derived from X-RateLimit-Reset.
+	MaxBackoff time.Duration
+}
+
+const (
+	defaultRetryMinBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// OptionRetry enables automatic retries in Client.Do with the given policy.
+// Zero MinBackoff and MaxBackoff fall back to 500ms and 30s.
+func OptionRetry(p RetryPolicy) func(*Client) {
+	if p.MinBackoff <= 0 {
+		p.MinBackoff = defaultRetryMinBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaultRetryMaxBackoff
+	}
+	return func(c *Client) {
+		c.retry = &p
+	}
+}
+
+// send executes req, retrying it according to the client's retry policy.
+// Every attempt goes through the client-side rate limiter when one is set.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	key := rateLimitKey(req)
+	for attempt := 1; ; attempt++ {
+		if err := c.limiter.wait(ctx, key); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpclient.Do(req)
+		c.limiter.update(key, resp)
+		if err != nil {
+			// If we got an error, and the context has been canceled,
+			// the context's error is probably more useful.
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
+
+		wait, ok := c.retry.backoff(req, resp, err, attempt)
+		if !ok {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if req.GetBody != nil {
+			body, er := req.GetBody()
+			if er != nil {
+				return nil, er
+			}
+			req.Body = body
+		}
+
+		c.Debugf("sendgrid: retrying %s %s in %s (attempt %d)", req.Method, req.URL.Path, wait, attempt+1)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff reports whether the attempt should be retried and how long to wait
+// before doing so.
+func (p *RetryPolicy) backoff(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	// a body that cannot be rewound cannot be sent twice
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	if err != nil {
+		if !isIdempotent(req.Method) || !isConnectionReset(err) {
+			return 0, false
+		}
+		return p.jitter(attempt), true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		if reset, er := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); er == nil {
+			if wait := time.Until(time.Unix(reset, 0)); wait > 0 {
+				return min(wait, p.MaxBackoff), true
+			}
+		}
+		return p.jitter(attempt), true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+		return p.jitter(attempt), true
+	}
+
+	return 0, false
+}
+
+// jitter returns a random delay in [0, min(MaxBackoff, MinBackoff*2^(attempt-1))).
+func (p *RetryPolicy) jitter(attempt int) time.Duration {
+	d := p.MinBackoff << (attempt - 1)
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return rand.N(d)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,145 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry_ServiceUnavailable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRetry(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if _, err := fmt.Fprint(w, `{"result":[{"api_key_id": "dummy", "name": "full-access"}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetAPIKeys(context.TODO())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, "dummy", expected.APIKeys[0].ApiKeyId)
+}
+
+func TestRetry_RateLimitedResendsBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRetry(RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		calls++
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.JSONEq(t, `{"name":"dummy","generation":"dynamic"}`, string(body))
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if _, err := fmt.Fprint(w, `{"id": "d-12345", "name": "dummy"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.CreateTemplate(context.TODO(), &InputCreateTemplate{Name: "dummy", Generation: "dynamic"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, "d-12345", expected.ID)
+}
+
+func TestRetry_NonIdempotentNotRetried(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRetry(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.CreateTemplate(context.TODO(), &InputCreateTemplate{Name: "dummy"})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetry_Exhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRetry(RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})(client)
+
+	calls := 0
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.GetAPIKeys(context.TODO())
+	var rateLimitedErr *RateLimitedError
+	if !errors.As(err, &rateLimitedErr) {
+		t.Fatalf("expected *RateLimitedError but got %v", err)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetry_ContextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRetry(RetryPolicy{MaxAttempts: 5, MinBackoff: time.Minute, MaxBackoff: time.Minute})(client)
+
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetAPIKeys(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded but got %v", err)
+	}
+}
+
+func TestRetry_Disabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.GetAPIKeys(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+	assert.Equal(t, 1, calls)
+}
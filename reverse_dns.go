@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -70,6 +71,29 @@ func (c *Client) GetReverseDNSs(ctx context.Context, input *InputGetReverseDNSs)
 	return r, nil
 }
 
+// AllReverseDNSs iterates over every reverse DNS record, advancing offset by limit.
+func (c *Client) AllReverseDNSs(ctx context.Context, input *InputGetReverseDNSs) iter.Seq2[*OutputGetReverseDNS, error] {
+	in := InputGetReverseDNSs{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*OutputGetReverseDNS] {
+		in := in
+		return func(ctx context.Context) ([]*OutputGetReverseDNS, bool, error) {
+			r, err := c.GetReverseDNSs(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
 // see: https://docs.sendgrid.com/api-reference/reverse-dns/retrieve-a-reverse-dns-record
 func (c *Client) GetReverseDNS(ctx context.Context, id int64) (*OutputGetReverseDNS, error) {
 	path := fmt.Sprintf("/whitelabel/ips/%v", id)
@@ -375,3 +375,31 @@ func TestDeleteReverseDNS_Failed(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestAllReverseDNSs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/whitelabel/ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		body := `[{"id":1},{"id":2}]`
+		if r.URL.Query().Get("offset") == "2" {
+			body = `[{"id":3}]`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []int64{}
+	for record, err := range client.AllReverseDNSs(context.TODO(), &InputGetReverseDNSs{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, record.ID)
+	}
+
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+	}
+}
@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -87,6 +88,29 @@ func (c *Client) GetAuthenticatedDomains(ctx context.Context, input *InputGetAut
 	return r, nil
 }
 
+// AllAuthenticatedDomains iterates over every authenticated domain, advancing offset by limit.
+func (c *Client) AllAuthenticatedDomains(ctx context.Context, input *InputGetAuthenticatedDomains) iter.Seq2[*DomainAuthentication, error] {
+	in := InputGetAuthenticatedDomains{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*DomainAuthentication] {
+		in := in
+		return func(ctx context.Context) ([]*DomainAuthentication, bool, error) {
+			r, err := c.GetAuthenticatedDomains(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
 type InputGetDefaultAuthentication struct {
 	Domain string
 }
@@ -1023,3 +1023,31 @@ func TestDisassociateAuthenticatedDomainWithSubuser_Failed(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestAllAuthenticatedDomains(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/whitelabel/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		body := `[{"id":1}]`
+		if r.URL.Query().Get("offset") == "1" {
+			body = `[]`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []int64{}
+	for domain, err := range client.AllAuthenticatedDomains(context.TODO(), &InputGetAuthenticatedDomains{Limit: 1}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, domain.ID)
+	}
+
+	if want := []int64{1}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+	}
+}
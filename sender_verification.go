@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -64,6 +65,31 @@ func (c *Client) GetVerifiedSenders(ctx context.Context, input *InputGetVerified
 	return r.VerifiedSenders, nil
 }
 
+// AllVerifiedSenders iterates over every verified sender, using the last seen ID as cursor.
+func (c *Client) AllVerifiedSenders(ctx context.Context, input *InputGetVerifiedSenders) iter.Seq2[*VerifiedSender, error] {
+	in := InputGetVerifiedSenders{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*VerifiedSender] {
+		in := in
+		return func(ctx context.Context) ([]*VerifiedSender, bool, error) {
+			r, err := c.GetVerifiedSenders(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			if len(r) > 0 {
+				in.LastSeenID = int(r[len(r)-1].ID)
+			}
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
 type InputCreateVerifiedSenderRequest struct {
 	Nickname    string `json:"nickname,omitempty"`
 	FromEmail   string `json:"from_email,omitempty"`
@@ -417,3 +417,31 @@ func TestGetSenderVerificationDomainWarnList_Failed(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestAllVerifiedSenders(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/verified_senders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		body := `{"results":[{"id":1},{"id":2}]}`
+		if r.URL.Query().Get("lastSeenID") == "2" {
+			body = `{"results":[{"id":3}]}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []int64{}
+	for sender, err := range client.AllVerifiedSenders(context.TODO(), &InputGetVerifiedSenders{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, sender.ID)
+	}
+
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+	}
+}
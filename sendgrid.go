@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
@@ -32,6 +33,10 @@ type Client struct {
 	log        ilogger
 	httpclient httpClient
 	subuser    string
+	retry      *RetryPolicy
+	middleware []Middleware
+	slog       *slog.Logger
+	limiter    *rateLimiter
 }
 
 // Option defines an option for a Client
@@ -169,8 +174,14 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
 // interface, the raw response body will be written to v, without attempting to
-// first decode it. If rate limit is exceeded and reset time is in the future,
-// Do returns *RateLimitError immediately without making a network API call.
+// first decode it. When the client was built with OptionRateLimiter and the
+// rate limit of the endpoint is known to be exceeded until a future reset
+// time, Do returns *RateLimitedError immediately without making a network API
+// call, or waits for the reset.
+// When the client was built with OptionRetry, rate limited and transient
+// failures are retried before any error is returned. Middleware configured
+// with OptionMiddleware wraps the request, retries included. The metadata of
+// the response is stored in the Response registered with WithResponse.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is canceled or times out,
 // ctx.Err() will be returned.
@@ -179,18 +190,15 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error
 		return errors.New("context must be non-nil")
 	}
 
+	if OperationFromContext(ctx) == "" {
+		ctx = WithOperation(ctx, callerOperation())
+	}
 	req = req.WithContext(ctx)
 
-	resp, err := c.httpclient.Do(req)
+	resp, err := c.chain(DoerFunc(func(req *http.Request) (*http.Response, error) {
+		return c.send(req.Context(), req)
+	})).Do(req)
 	if err != nil {
-		// If we got an error, and the context has been canceled,
-		// the context's error is probably more useful.
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
-
 		return err
 	}
 	defer func() {
@@ -199,6 +207,8 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error
 		}
 	}()
 
+	captureResponse(ctx, resp)
+
 	err = checkStatusCode(resp, c)
 	if err != nil {
 		return err
@@ -0,0 +1,643 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// resource describes a collection served with generic CRUD handlers.
+type resource struct {
+	name    string
+	path    string
+	idField string
+	// newID generates the identifier of a created item. Numeric identifiers
+	// are generated when it is nil.
+	newID func(s *Server, item map[string]any) string
+	// list builds the body of the list response.
+	list func(r *http.Request, items []map[string]any) any
+	// create fills server-side fields of a created item and returns the
+	// create response body, or nil to respond with the item itself.
+	create  func(s *Server, item map[string]any) map[string]any
+	updates []string
+}
+
+func (s *Server) routes() {
+	s.crud(resource{
+		name:    "api_keys",
+		path:    "/api_keys",
+		idField: "api_key_id",
+		newID:   func(s *Server, _ map[string]any) string { return fmt.Sprintf("key-%d", s.id()) },
+		list:    wrap("result"),
+		create: func(s *Server, item map[string]any) map[string]any {
+			resp := maps.Clone(item)
+			resp["api_key"] = fmt.Sprintf("SG.%s.secret", item["api_key_id"])
+			return resp
+		},
+		updates: []string{"PATCH", "PUT"},
+	})
+
+	s.crud(resource{
+		name:    "templates",
+		path:    "/templates",
+		idField: "id",
+		newID:   func(s *Server, _ map[string]any) string { return fmt.Sprintf("d-%032d", s.id()) },
+		list: func(r *http.Request, items []map[string]any) any {
+			generations := r.URL.Query().Get("generations")
+			filtered := []map[string]any{}
+			for _, item := range items {
+				if generations == "" || item["generation"] == generations {
+					filtered = append(filtered, item)
+				}
+			}
+			result, metadata := tokenPage(r, filtered)
+			return map[string]any{"result": result, "_metadata": metadata}
+		},
+		create: func(s *Server, item map[string]any) map[string]any {
+			if _, ok := item["generation"]; !ok {
+				item["generation"] = "legacy"
+			}
+			item["updated_at"] = now()
+			item["versions"] = []any{}
+			return nil
+		},
+		updates: []string{"PATCH"},
+	})
+	s.handle("POST /templates/{id}", s.duplicateTemplate)
+	s.handle("POST /templates/{id}/versions", s.createTemplateVersion)
+	s.handle("GET /templates/{id}/versions/{version}", s.getTemplateVersion)
+	s.handle("PATCH /templates/{id}/versions/{version}", s.updateTemplateVersion)
+	s.handle("POST /templates/{id}/versions/{version}/activate", s.activateTemplateVersion)
+	s.handle("DELETE /templates/{id}/versions/{version}", s.deleteTemplateVersion)
+
+	s.crud(resource{
+		name:    "designs",
+		path:    "/designs",
+		idField: "id",
+		newID:   func(s *Server, _ map[string]any) string { return fmt.Sprintf("00000000-0000-0000-0000-%012d", s.id()) },
+		list: func(r *http.Request, items []map[string]any) any {
+			result, metadata := tokenPage(r, items)
+			return map[string]any{"result": result, "_metadata": metadata}
+		},
+		create: func(s *Server, item map[string]any) map[string]any {
+			if _, ok := item["editor"]; !ok {
+				item["editor"] = "code"
+			}
+			item["created_at"] = now()
+			item["updated_at"] = now()
+			return nil
+		},
+		updates: []string{"PATCH"},
+	})
+
+	s.crud(resource{
+		name:    "subusers",
+		path:    "/subusers",
+		idField: "username",
+		newID:   func(_ *Server, item map[string]any) string { return fmt.Sprint(item["username"]) },
+		list: func(r *http.Request, items []map[string]any) any {
+			username := r.URL.Query().Get("username")
+			filtered := []map[string]any{}
+			for _, item := range items {
+				if username == "" || item["username"] == username {
+					filtered = append(filtered, item)
+				}
+			}
+			return page(r, filtered)
+		},
+		create: func(s *Server, item map[string]any) map[string]any {
+			id := s.id()
+			delete(item, "password")
+			item["id"] = id
+			item["disabled"] = false
+			return map[string]any{
+				"user_id":           id,
+				"username":          item["username"],
+				"email":             item["email"],
+				"credit_allocation": map[string]any{"type": "unlimited"},
+			}
+		},
+		updates: []string{"PATCH"},
+	})
+	s.handle("PUT /subusers/{id}/ips", s.updateSubuserIPs)
+
+	s.teammateRoutes()
+
+	s.crud(resource{
+		name:    "domains",
+		path:    "/whitelabel/domains",
+		idField: "id",
+		list:    func(r *http.Request, items []map[string]any) any { return page(r, items) },
+		create:  defaults(map[string]any{"valid": false, "legacy": false, "default": false}),
+		updates: []string{"PATCH"},
+	})
+	s.handle("POST /whitelabel/domains/{id}/validate", s.validate("domains"))
+
+	s.crud(resource{
+		name:    "links",
+		path:    "/whitelabel/links",
+		idField: "id",
+		list:    func(r *http.Request, items []map[string]any) any { return page(r, items) },
+		create:  defaults(map[string]any{"valid": false, "legacy": false}),
+		updates: []string{"PATCH"},
+	})
+	s.handle("GET /whitelabel/links/default", s.getDefaultLink)
+	s.handle("POST /whitelabel/links/{id}/validate", s.validate("links"))
+
+	s.crud(resource{
+		name:    "reverse_dns",
+		path:    "/whitelabel/ips",
+		idField: "id",
+		list:    func(r *http.Request, items []map[string]any) any { return page(r, items) },
+		create: func(s *Server, item map[string]any) map[string]any {
+			item["rdns"] = fmt.Sprintf("%s.%s", item["subdomain"], item["domain"])
+			item["valid"] = false
+			return nil
+		},
+	})
+	s.handle("POST /whitelabel/ips/{id}/validate", s.validate("reverse_dns"))
+
+	s.crud(resource{
+		name:    "sso_integrations",
+		path:    "/sso/integrations",
+		idField: "id",
+		newID:   func(s *Server, _ map[string]any) string { return fmt.Sprintf("integration-%d", s.id()) },
+		list:    func(_ *http.Request, items []map[string]any) any { return items },
+		updates: []string{"PATCH"},
+	})
+	s.crud(resource{
+		name:    "sso_certificates",
+		path:    "/sso/certificates",
+		idField: "id",
+		list:    func(_ *http.Request, items []map[string]any) any { return items },
+		updates: []string{"PATCH"},
+	})
+	s.handle("GET /sso/integrations/{id}/certificates", s.getSSOCertificates)
+
+	s.crud(resource{
+		name:    "event_webhooks",
+		path:    "/user/webhooks/event/settings",
+		idField: "id",
+		newID:   func(s *Server, _ map[string]any) string { return fmt.Sprintf("webhook-%d", s.id()) },
+		create: func(s *Server, item map[string]any) map[string]any {
+			item["created_date"] = now()
+			item["updated_date"] = now()
+			return nil
+		},
+		updates: []string{"PATCH"},
+	})
+	s.handle("GET /user/webhooks/event/settings/all", func(w http.ResponseWriter, r *http.Request, a *account) {
+		writeJSON(w, http.StatusOK, map[string]any{"max_allowed": 5, "webhooks": a.store("event_webhooks").list()})
+	})
+
+	s.crud(resource{
+		name:    "parse_webhooks",
+		path:    "/user/webhooks/parse/settings",
+		idField: "hostname",
+		newID:   func(_ *Server, item map[string]any) string { return fmt.Sprint(item["hostname"]) },
+		list:    wrap("result"),
+		updates: []string{"PATCH"},
+	})
+
+	s.crud(resource{
+		name:    "suppression_groups",
+		path:    "/asm/groups",
+		idField: "id",
+		list:    func(_ *http.Request, items []map[string]any) any { return items },
+		create:  defaults(map[string]any{"unsubscribes": 0}),
+		updates: []string{"PATCH"},
+	})
+
+	s.trackingRoutes()
+}
+
+// crud registers list, create, get, update and delete handlers for res.
+func (s *Server) crud(res resource) {
+	item := res.path + "/{id}"
+
+	if res.list != nil {
+		s.handle("GET "+res.path, func(w http.ResponseWriter, r *http.Request, a *account) {
+			writeJSON(w, http.StatusOK, res.list(r, a.store(res.name).list()))
+		})
+	}
+
+	s.handle("POST "+res.path, func(w http.ResponseWriter, r *http.Request, a *account) {
+		body := map[string]any{}
+		if !decode(w, r, &body) {
+			return
+		}
+
+		var id string
+		if res.newID != nil {
+			id = res.newID(s, body)
+			body[res.idField] = id
+		} else {
+			n := s.id()
+			id = strconv.FormatInt(n, 10)
+			body[res.idField] = n
+		}
+
+		st := a.store(res.name)
+		if _, ok := st.get(id); ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf("%s already exists", id))
+			return
+		}
+
+		resp := body
+		if res.create != nil {
+			if v := res.create(s, body); v != nil {
+				resp = v
+			}
+		}
+		st.put(id, body)
+		writeJSON(w, http.StatusCreated, resp)
+	})
+
+	s.handle("GET "+item, func(w http.ResponseWriter, r *http.Request, a *account) {
+		v, ok := a.store(res.name).get(r.PathValue("id"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, v)
+	})
+
+	for _, method := range res.updates {
+		s.handle(method+" "+item, func(w http.ResponseWriter, r *http.Request, a *account) {
+			v, ok := a.store(res.name).get(r.PathValue("id"))
+			if !ok {
+				notFound(w)
+				return
+			}
+			patch := map[string]any{}
+			if !decode(w, r, &patch) {
+				return
+			}
+			delete(patch, res.idField)
+			writeJSON(w, http.StatusOK, merge(v, patch))
+		})
+	}
+
+	s.handle("DELETE "+item, func(w http.ResponseWriter, r *http.Request, a *account) {
+		if !a.store(res.name).delete(r.PathValue("id")) {
+			notFound(w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func wrap(key string) func(*http.Request, []map[string]any) any {
+	return func(_ *http.Request, items []map[string]any) any {
+		return map[string]any{key: items}
+	}
+}
+
+func defaults(fields map[string]any) func(*Server, map[string]any) map[string]any {
+	return func(_ *Server, item map[string]any) map[string]any {
+		for k, v := range fields {
+			if _, ok := item[k]; !ok {
+				item[k] = v
+			}
+		}
+		return nil
+	}
+}
+
+func (s *Server) validate(name string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, a *account) {
+		v, ok := a.store(name).get(r.PathValue("id"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		v["valid"] = true
+		writeJSON(w, http.StatusOK, map[string]any{"id": v["id"], "valid": true, "validation_results": map[string]any{}})
+	}
+}
+
+func (s *Server) getDefaultLink(w http.ResponseWriter, r *http.Request, a *account) {
+	for _, v := range a.store("links").list() {
+		if v["default"] == true {
+			writeJSON(w, http.StatusOK, v)
+			return
+		}
+	}
+	notFound(w)
+}
+
+func (s *Server) updateSubuserIPs(w http.ResponseWriter, r *http.Request, a *account) {
+	v, ok := a.store("subusers").get(r.PathValue("id"))
+	if !ok {
+		notFound(w)
+		return
+	}
+	ips := []string{}
+	if !decode(w, r, &ips) {
+		return
+	}
+	v["ips"] = ips
+	writeJSON(w, http.StatusOK, ips)
+}
+
+func (s *Server) getSSOCertificates(w http.ResponseWriter, r *http.Request, a *account) {
+	certificates := []map[string]any{}
+	for _, v := range a.store("sso_certificates").list() {
+		if v["integration_id"] == r.PathValue("id") {
+			certificates = append(certificates, v)
+		}
+	}
+	writeJSON(w, http.StatusOK, certificates)
+}
+
+func (s *Server) duplicateTemplate(w http.ResponseWriter, r *http.Request, a *account) {
+	v, ok := a.store("templates").get(r.PathValue("id"))
+	if !ok {
+		notFound(w)
+		return
+	}
+	body := map[string]any{}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	dup := maps.Clone(v)
+	id := fmt.Sprintf("d-%032d", s.id())
+	dup["id"] = id
+	dup["updated_at"] = now()
+	if name, ok := body["name"]; ok {
+		dup["name"] = name
+	}
+	versions := []any{}
+	for _, version := range v["versions"].([]any) {
+		cp := maps.Clone(version.(map[string]any))
+		cp["id"] = fmt.Sprintf("version-%d", s.id())
+		cp["template_id"] = id
+		versions = append(versions, cp)
+	}
+	dup["versions"] = versions
+	a.store("templates").put(id, dup)
+	writeJSON(w, http.StatusCreated, dup)
+}
+
+func (s *Server) createTemplateVersion(w http.ResponseWriter, r *http.Request, a *account) {
+	v, ok := a.store("templates").get(r.PathValue("id"))
+	if !ok {
+		notFound(w)
+		return
+	}
+	version := map[string]any{}
+	if !decode(w, r, &version) {
+		return
+	}
+
+	version["id"] = fmt.Sprintf("version-%d", s.id())
+	version["template_id"] = v["id"]
+	version["updated_at"] = now()
+	if _, ok := version["active"]; !ok {
+		version["active"] = 0
+	}
+	versions := v["versions"].([]any)
+	if version["active"] == float64(1) {
+		deactivate(versions)
+	}
+	v["versions"] = append(versions, version)
+	writeJSON(w, http.StatusCreated, version)
+}
+
+// templateVersion looks up the version addressed by the request.
+func templateVersion(r *http.Request, a *account) (map[string]any, int, bool) {
+	v, ok := a.store("templates").get(r.PathValue("id"))
+	if !ok {
+		return nil, 0, false
+	}
+	for i, version := range v["versions"].([]any) {
+		if version := version.(map[string]any); version["id"] == r.PathValue("version") {
+			return version, i, true
+		}
+	}
+	return nil, 0, false
+}
+
+func (s *Server) getTemplateVersion(w http.ResponseWriter, r *http.Request, a *account) {
+	version, _, ok := templateVersion(r, a)
+	if !ok {
+		notFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, version)
+}
+
+func (s *Server) updateTemplateVersion(w http.ResponseWriter, r *http.Request, a *account) {
+	version, _, ok := templateVersion(r, a)
+	if !ok {
+		notFound(w)
+		return
+	}
+	patch := map[string]any{}
+	if !decode(w, r, &patch) {
+		return
+	}
+	delete(patch, "id")
+	delete(patch, "template_id")
+	if patch["active"] == float64(1) {
+		v, _ := a.store("templates").get(r.PathValue("id"))
+		deactivate(v["versions"].([]any))
+	}
+	version["updated_at"] = now()
+	writeJSON(w, http.StatusOK, merge(version, patch))
+}
+
+func (s *Server) activateTemplateVersion(w http.ResponseWriter, r *http.Request, a *account) {
+	version, _, ok := templateVersion(r, a)
+	if !ok {
+		notFound(w)
+		return
+	}
+	v, _ := a.store("templates").get(r.PathValue("id"))
+	deactivate(v["versions"].([]any))
+	version["active"] = 1
+	writeJSON(w, http.StatusOK, version)
+}
+
+func (s *Server) deleteTemplateVersion(w http.ResponseWriter, r *http.Request, a *account) {
+	_, i, ok := templateVersion(r, a)
+	if !ok {
+		notFound(w)
+		return
+	}
+	v, _ := a.store("templates").get(r.PathValue("id"))
+	versions := v["versions"].([]any)
+	v["versions"] = append(versions[:i:i], versions[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func deactivate(versions []any) {
+	for _, version := range versions {
+		version.(map[string]any)["active"] = 0
+	}
+}
+
+func (s *Server) teammateRoutes() {
+	s.handle("GET /teammates", func(w http.ResponseWriter, r *http.Request, a *account) {
+		writeJSON(w, http.StatusOK, map[string]any{"result": page(r, a.store("teammates").list())})
+	})
+	s.handle("GET /teammates/pending", func(w http.ResponseWriter, r *http.Request, a *account) {
+		writeJSON(w, http.StatusOK, map[string]any{"result": a.store("pending_teammates").list()})
+	})
+
+	s.handle("POST /teammates", func(w http.ResponseWriter, r *http.Request, a *account) {
+		invite := map[string]any{}
+		if !decode(w, r, &invite) {
+			return
+		}
+		token := fmt.Sprintf("token-%d", s.id())
+		invite["token"] = token
+		invite["expiration_date"] = time.Now().Add(7 * 24 * time.Hour).Unix()
+		a.store("pending_teammates").put(token, invite)
+		writeJSON(w, http.StatusCreated, invite)
+	})
+	s.handle("POST /sso/teammates", func(w http.ResponseWriter, r *http.Request, a *account) {
+		teammate := map[string]any{}
+		if !decode(w, r, &teammate) {
+			return
+		}
+		username := fmt.Sprint(teammate["email"])
+		if _, ok := a.store("teammates").get(username); ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf("%s already exists", username))
+			return
+		}
+		teammate["username"] = username
+		teammate["user_type"] = userType(teammate)
+		a.store("teammates").put(username, teammate)
+		writeJSON(w, http.StatusCreated, teammate)
+	})
+
+	s.handle("GET /teammates/{username}", func(w http.ResponseWriter, r *http.Request, a *account) {
+		v, ok := a.store("teammates").get(r.PathValue("username"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, v)
+	})
+
+	update := func(w http.ResponseWriter, r *http.Request, a *account) {
+		v, ok := a.store("teammates").get(r.PathValue("username"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		patch := map[string]any{}
+		if !decode(w, r, &patch) {
+			return
+		}
+		delete(patch, "username")
+		merge(v, patch)
+		v["user_type"] = userType(v)
+		writeJSON(w, http.StatusOK, v)
+	}
+	s.handle("PATCH /teammates/{username}", update)
+	s.handle("PATCH /sso/teammates/{username}", update)
+
+	s.handle("DELETE /teammates/{username}", func(w http.ResponseWriter, r *http.Request, a *account) {
+		if !a.store("teammates").delete(r.PathValue("username")) {
+			notFound(w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("DELETE /teammates/pending/{token}", func(w http.ResponseWriter, r *http.Request, a *account) {
+		if !a.store("pending_teammates").delete(r.PathValue("token")) {
+			notFound(w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.handle("GET /teammates/{username}/subuser_access", func(w http.ResponseWriter, r *http.Request, a *account) {
+		v, ok := a.store("teammates").get(r.PathValue("username"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		access := []map[string]any{}
+		if list, ok := v["subuser_access"].([]any); ok {
+			for _, item := range list {
+				access = append(access, item.(map[string]any))
+			}
+		}
+		restricted, _ := v["has_restricted_subuser_access"].(bool)
+		writeJSON(w, http.StatusOK, map[string]any{
+			"has_restricted_subuser_access": restricted,
+			"subuser_access":                access,
+			"_metadata":                     map[string]any{"next_params": map[string]any{"limit": len(access)}},
+		})
+	})
+}
+
+func userType(teammate map[string]any) string {
+	if teammate["is_admin"] == true {
+		return "admin"
+	}
+	return "teammate"
+}
+
+// setting describes a singleton account setting served by GET and PATCH.
+type setting struct {
+	name        string
+	path        string
+	title       string
+	description string
+	defaults    map[string]any
+}
+
+var trackingSettings = []setting{
+	{name: "click", path: "/tracking_settings/click", title: "Click Tracking", description: "lists and enables click tracking", defaults: map[string]any{"enabled": false, "enable_text": false}},
+	{name: "open", path: "/tracking_settings/open", title: "Open Tracking", description: "lists and enables open tracking", defaults: map[string]any{"enabled": false}},
+	{name: "google_analytics", path: "/tracking_settings/google_analytics", title: "Google Analytics", description: "lists and enables google analytics", defaults: map[string]any{"enabled": false}},
+	{name: "subscription", path: "/tracking_settings/subscription", title: "Subscription Tracking", description: "lists and enables subscription tracking", defaults: map[string]any{"enabled": false}},
+}
+
+func (s *Server) trackingRoutes() {
+	for _, st := range trackingSettings {
+		s.setting(st)
+	}
+	s.handle("GET /tracking_settings", func(w http.ResponseWriter, r *http.Request, a *account) {
+		result := []map[string]any{}
+		for _, st := range trackingSettings {
+			result = append(result, map[string]any{
+				"name":        st.name,
+				"title":       st.title,
+				"description": st.description,
+				"enabled":     a.setting(st)["enabled"],
+			})
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"result": result})
+	})
+}
+
+// setting registers GET and PATCH handlers for st.
+func (s *Server) setting(st setting) {
+	s.handle("GET "+st.path, func(w http.ResponseWriter, r *http.Request, a *account) {
+		writeJSON(w, http.StatusOK, a.setting(st))
+	})
+	s.handle("PATCH "+st.path, func(w http.ResponseWriter, r *http.Request, a *account) {
+		patch := map[string]any{}
+		if !decode(w, r, &patch) {
+			return
+		}
+		writeJSON(w, http.StatusOK, merge(a.setting(st), patch))
+	})
+}
+
+func (a *account) setting(st setting) map[string]any {
+	v, ok := a.settings[st.path]
+	if !ok {
+		v = maps.Clone(st.defaults)
+		a.settings[st.path] = v
+	}
+	return v
+}
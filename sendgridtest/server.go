@@ -0,0 +1,296 @@
+// Package sendgridtest provides an in-memory fake of the SendGrid v3 API for
+// testing code built on github.com/kenzo0107/sendgrid.
+//
+//	fake := sendgridtest.NewServer()
+//	defer fake.Close()
+//
+//	c := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+//
+// Resources are kept per account: requests carrying an On-Behalf-Of header
+// read and write the state of that subuser, other requests the parent account.
+package sendgridtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Server is a stateful fake SendGrid API server.
+type Server struct {
+	// URL is the base URL to pass to sendgrid.OptionBaseURL, including the /v3 prefix.
+	URL string
+
+	server *httptest.Server
+	mux    *http.ServeMux
+
+	mu       sync.Mutex
+	accounts map[string]*account
+	faults   []*fault
+	nextID   int64
+}
+
+type account struct {
+	stores   map[string]*store
+	settings map[string]map[string]any
+}
+
+type fault struct {
+	method    string
+	path      string
+	status    int
+	remaining int
+	header    http.Header
+}
+
+// NewServer starts a fake server. It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		mux:      http.NewServeMux(),
+		accounts: map[string]*account{},
+	}
+	s.routes()
+	s.server = httptest.NewServer(s.mux)
+	s.URL = s.server.URL + "/v3"
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Fail makes the next n requests matching method and path respond with status.
+// An empty method matches every method, path is matched as a prefix of the
+// request path without the /v3 prefix, e.g. "/templates". Fail does nothing
+// when n <= 0.
+func (s *Server) Fail(method, path string, status, n int) {
+	if n <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{method: method, path: path, status: status, remaining: n})
+}
+
+// RateLimit makes the next n requests matching method and path respond with
+// 429 Too Many Requests and an X-RateLimit-Reset header set to reset.
+// RateLimit does nothing when n <= 0.
+func (s *Server) RateLimit(method, path string, reset time.Time, n int) {
+	if n <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "600")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	s.faults = append(s.faults, &fault{method: method, path: path, status: http.StatusTooManyRequests, remaining: n, header: header})
+}
+
+// Reset drops every stored resource and pending fault.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accounts = map[string]*account{}
+	s.faults = nil
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, a *account)
+
+// handle registers fn for pattern, relative to /v3. Handlers run under the
+// server lock with the account selected by On-Behalf-Of.
+func (s *Server) handle(pattern string, fn handlerFunc) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.mux.HandleFunc(method+" /v3"+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); !ok || token == "" {
+			writeError(w, http.StatusUnauthorized, "authorization required")
+			return
+		}
+
+		if s.injectFault(w, r) {
+			return
+		}
+
+		fn(w, r, s.account(r.Header.Get("On-Behalf-Of")))
+	})
+}
+
+func (s *Server) injectFault(w http.ResponseWriter, r *http.Request) bool {
+	path := strings.TrimPrefix(r.URL.Path, "/v3")
+	for i, f := range s.faults {
+		if f.method != "" && f.method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(path, f.path) {
+			continue
+		}
+
+		f.remaining--
+		if f.remaining <= 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		for k, v := range f.header {
+			w.Header()[k] = v
+		}
+		writeError(w, f.status, http.StatusText(f.status))
+		return true
+	}
+	return false
+}
+
+func (s *Server) account(name string) *account {
+	a, ok := s.accounts[name]
+	if !ok {
+		a = &account{
+			stores:   map[string]*store{},
+			settings: map[string]map[string]any{},
+		}
+		s.accounts[name] = a
+	}
+	return a
+}
+
+func (a *account) store(name string) *store {
+	st, ok := a.stores[name]
+	if !ok {
+		st = &store{items: map[string]map[string]any{}}
+		a.stores[name] = st
+	}
+	return st
+}
+
+func (s *Server) id() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+// store keeps resources as decoded JSON objects in insertion order.
+type store struct {
+	items map[string]map[string]any
+	order []string
+}
+
+func (st *store) list() []map[string]any {
+	items := make([]map[string]any, 0, len(st.order))
+	for _, id := range st.order {
+		items = append(items, st.items[id])
+	}
+	return items
+}
+
+func (st *store) get(id string) (map[string]any, bool) {
+	item, ok := st.items[id]
+	return item, ok
+}
+
+func (st *store) put(id string, item map[string]any) {
+	if _, ok := st.items[id]; !ok {
+		st.order = append(st.order, id)
+	}
+	st.items[id] = item
+}
+
+func (st *store) delete(id string) bool {
+	if _, ok := st.items[id]; !ok {
+		return false
+	}
+	delete(st.items, id)
+	for i, v := range st.order {
+		if v == id {
+			st.order = append(st.order[:i], st.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func decode(w http.ResponseWriter, r *http.Request, dst any) bool {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]any{{"field": nil, "message": message}},
+	})
+}
+
+func notFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "resource not found")
+}
+
+// merge copies the fields of patch into item.
+func merge(item, patch map[string]any) map[string]any {
+	for k, v := range patch {
+		item[k] = v
+	}
+	return item
+}
+
+// page applies limit and offset query parameters to items.
+func page(r *http.Request, items []map[string]any) []map[string]any {
+	q := r.URL.Query()
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	if offset > len(items) {
+		offset = len(items)
+	}
+	items = items[offset:]
+	if limit, _ := strconv.Atoi(q.Get("limit")); limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// tokenPage applies page_size and page_token query parameters to items and
+// returns the _metadata object of the page.
+func tokenPage(r *http.Request, items []map[string]any) ([]map[string]any, map[string]any) {
+	q := r.URL.Query()
+	total := len(items)
+	offset, _ := strconv.Atoi(q.Get("page_token"))
+	if offset > total {
+		offset = total
+	}
+	size, _ := strconv.Atoi(q.Get("page_size"))
+	if size <= 0 {
+		size = total - offset
+	}
+
+	end := min(offset+size, total)
+	metadata := map[string]any{"count": total}
+	if end < total {
+		next := *r.URL
+		nq := next.Query()
+		nq.Set("page_token", strconv.Itoa(end))
+		next.RawQuery = nq.Encode()
+		metadata["next"] = "http://" + r.Host + next.String()
+	}
+	return items[offset:end], metadata
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02 15:04:05")
+}
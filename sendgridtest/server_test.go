@@ -0,0 +1,215 @@
+package sendgridtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/sendgrid/sendgridtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_APIKeys(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	c := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+
+	created, err := c.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{Name: "ci", Scopes: []string{"mail.send"}})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ApiKey)
+	assert.Equal(t, "ci", created.Name)
+
+	_, err = c.UpdateAPIKeyName(ctx, created.ApiKeyId, &sendgrid.InputUpdateAPIKeyName{Name: "deploy"})
+	require.NoError(t, err)
+
+	got, err := c.GetAPIKey(ctx, created.ApiKeyId)
+	require.NoError(t, err)
+	assert.Equal(t, "deploy", got.Name)
+	assert.Equal(t, []string{"mail.send"}, got.Scopes)
+
+	keys, err := c.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	assert.Len(t, keys.APIKeys, 1)
+
+	require.NoError(t, c.DeleteAPIKey(ctx, created.ApiKeyId))
+	_, err = c.GetAPIKey(ctx, created.ApiKeyId)
+	assert.True(t, sendgrid.IsNotFound(err))
+}
+
+func TestServer_TemplateVersions(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	c := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+
+	template, err := c.CreateTemplate(ctx, &sendgrid.InputCreateTemplate{Name: "welcome", Generation: "dynamic"})
+	require.NoError(t, err)
+
+	v1, err := c.CreateTemplateVersion(ctx, template.ID, &sendgrid.InputCreateTemplateVersion{Name: "v1", Subject: "hello", Active: 1})
+	require.NoError(t, err)
+	v2, err := c.CreateTemplateVersion(ctx, template.ID, &sendgrid.InputCreateTemplateVersion{Name: "v2", Subject: "hello"})
+	require.NoError(t, err)
+
+	_, err = c.ActivateTemplateVersion(ctx, template.ID, v2.ID)
+	require.NoError(t, err)
+
+	got, err := c.GetTemplate(ctx, template.ID)
+	require.NoError(t, err)
+	assert.Len(t, got.Versions, 2)
+
+	version, err := c.GetTemplateVersion(ctx, template.ID, v1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version.Active)
+	version, err = c.GetTemplateVersion(ctx, template.ID, v2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version.Active)
+
+	require.NoError(t, c.DeleteTemplateVersion(ctx, template.ID, v1.ID))
+	_, err = c.GetTemplateVersion(ctx, template.ID, v1.ID)
+	assert.True(t, sendgrid.IsNotFound(err))
+
+	dup, err := c.DuplicateTemplate(ctx, template.ID, &sendgrid.InputDuplicateTemplate{Name: "welcome copy"})
+	require.NoError(t, err)
+	assert.NotEqual(t, template.ID, dup.ID)
+	assert.Len(t, dup.Versions, 1)
+
+	var names []string
+	for tmpl, err := range c.AllTemplates(ctx, &sendgrid.InputGetTemplates{Generations: "dynamic", PageSize: 1}) {
+		require.NoError(t, err)
+		names = append(names, tmpl.Name)
+	}
+	assert.Equal(t, []string{"welcome", "welcome copy"}, names)
+}
+
+func TestServer_TrackingSettings(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	c := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+
+	_, err := c.UpdateClickTrackingSettings(ctx, &sendgrid.InputUpdateClickTrackingSettings{Enabled: true})
+	require.NoError(t, err)
+
+	click, err := c.GetClickTrackingSettings(ctx)
+	require.NoError(t, err)
+	assert.True(t, click.Enabled)
+
+	settings, err := c.GetTrackingSettings(ctx)
+	require.NoError(t, err)
+	for _, s := range settings.Result {
+		assert.Equal(t, s.Name == "click", s.Enabled, s.Name)
+	}
+}
+
+func TestServer_OnBehalfOf(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	parent := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+	subuser := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL), sendgrid.OptionSubuser("dummy"))
+
+	_, err := subuser.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{Name: "subuser"})
+	require.NoError(t, err)
+
+	keys, err := parent.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, keys.APIKeys)
+
+	keys, err = subuser.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	assert.Len(t, keys.APIKeys, 1)
+}
+
+func TestServer_Fail(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	c := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+
+	fake.Fail("GET", "/api_keys", http.StatusServiceUnavailable, 1)
+
+	_, err := c.GetAPIKeys(ctx)
+	var apiErr *sendgrid.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+
+	_, err = c.GetAPIKeys(ctx)
+	assert.NoError(t, err)
+}
+
+func TestServer_Fail_NonPositive(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	c := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+
+	fake.Fail("GET", "/api_keys", http.StatusServiceUnavailable, 0)
+	fake.Fail("GET", "/api_keys", http.StatusServiceUnavailable, -1)
+	fake.RateLimit("GET", "/api_keys", time.Now(), 0)
+
+	_, err := c.GetAPIKeys(ctx)
+	assert.NoError(t, err)
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	c := sendgrid.New("test",
+		sendgrid.OptionBaseURL(fake.URL),
+		sendgrid.OptionRetry(sendgrid.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+	)
+
+	fake.RateLimit("", "/api_keys", time.Now(), 2)
+
+	_, err := c.GetAPIKeys(ctx)
+	assert.NoError(t, err)
+
+	fake.RateLimit("", "/api_keys", time.Now(), 3)
+
+	_, err = c.GetAPIKeys(ctx)
+	var rateLimitedErr *sendgrid.RateLimitedError
+	assert.True(t, errors.As(err, &rateLimitedErr))
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	c := sendgrid.New("", sendgrid.OptionBaseURL(fake.URL))
+
+	_, err := c.GetAPIKeys(context.TODO())
+	assert.True(t, sendgrid.IsUnauthorized(err))
+}
+
+func TestServer_Reset(t *testing.T) {
+	fake := sendgridtest.NewServer()
+	defer fake.Close()
+
+	ctx := context.TODO()
+	c := sendgrid.New("test", sendgrid.OptionBaseURL(fake.URL))
+
+	_, err := c.CreateSubuser(ctx, &sendgrid.InputCreateSubuser{Username: "dummy", Email: "dummy@example.com", Password: "password", Ips: []string{"1.1.1.1"}})
+	require.NoError(t, err)
+
+	_, err = c.CreateSubuser(ctx, &sendgrid.InputCreateSubuser{Username: "dummy", Email: "dummy@example.com", Password: "password", Ips: []string{"1.1.1.1"}})
+	assert.True(t, sendgrid.IsConflict(err))
+
+	fake.Reset()
+
+	subusers, err := c.GetSubusers(ctx, &sendgrid.InputGetSubusers{})
+	require.NoError(t, err)
+	assert.Empty(t, subusers)
+}
@@ -0,0 +1,88 @@
+package sendgrid
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// maxLogBodySize caps the bytes of a request or response body written to the
+// log. Bodies are redacted before they are cut to this size.
+const maxLogBodySize = 64 << 10
+
+// OptionSlog logs every request and response sent by Client.Do as structured
+// debug events. Credentials and other sensitive headers, query parameters and
+// JSON fields are redacted. Bodies are only read for logging when l is enabled for debug.
+func OptionSlog(l *slog.Logger) func(*Client) {
+	return func(c *Client) {
+		c.slog = l
+	}
+}
+
+// logging is the innermost middleware, so it logs requests as sent, after
+// every user middleware has run.
+func (c *Client) logging(next Doer) Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		ctx := req.Context()
+		if !c.slog.Enabled(ctx, slog.LevelDebug) {
+			return next.Do(req)
+		}
+
+		attrs := []slog.Attr{
+			slog.String("operation", OperationFromContext(ctx)),
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+		}
+		if subuser := req.Header.Get("On-Behalf-Of"); subuser != "" {
+			attrs = append(attrs, slog.String("subuser", subuser))
+		}
+
+		c.slog.LogAttrs(ctx, slog.LevelDebug, "sendgrid request", append(attrs,
+			slog.String("query", redactQuery(req.URL.RawQuery)),
+			slog.Any("header", redactHeader(req.Header)),
+			slog.String("body", truncate(redactBody(requestBody(req)), maxLogBodySize)),
+		)...)
+
+		start := time.Now()
+		resp, err := next.Do(req)
+		attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+		if err != nil {
+			c.slog.LogAttrs(ctx, slog.LevelDebug, "sendgrid request failed", append(attrs, slog.Any("error", err))...)
+			return resp, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return resp, err
+		}
+
+		c.slog.LogAttrs(ctx, slog.LevelDebug, "sendgrid response", append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.String("request_id", resp.Header.Get("X-Request-Id")),
+			slog.Any("header", redactHeader(resp.Header)),
+			slog.String("body", truncate(redactBody(body), maxLogBodySize)),
+		)...)
+
+		return resp, nil
+	})
+}
+
+// requestBody returns a copy of the request body without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer rc.Close()
+
+	// the whole body is read, as redactBody cannot parse a truncated one
+	body, _ := io.ReadAll(rc)
+	return body
+}
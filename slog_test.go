@@ -0,0 +1,185 @@
+package sendgrid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionSlog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "dummy-request-id")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"api_key": "SG.secret", "api_key_id": "dummy", "name": "dummy"}`)
+	})
+
+	var buf bytes.Buffer
+	OptionSlog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))(client)
+	client.apiKey = "SG.client-secret"
+
+	r, err := client.CreateAPIKey(context.TODO(), &InputCreateAPIKey{Name: "dummy"})
+	require.NoError(t, err)
+	assert.Equal(t, "SG.secret", r.ApiKey, "the response body must still be decoded")
+
+	assert.NotContains(t, buf.String(), "secret")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var req, resp map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &req))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &resp))
+
+	assert.Equal(t, "sendgrid request", req["msg"])
+	assert.Equal(t, "CreateAPIKey", req["operation"])
+	assert.Equal(t, "POST", req["method"])
+	assert.Equal(t, []interface{}{redacted}, req["header"].(map[string]interface{})["Authorization"])
+
+	assert.Equal(t, "sendgrid response", resp["msg"])
+	assert.Equal(t, float64(http.StatusCreated), resp["status"])
+	assert.Equal(t, "dummy-request-id", resp["request_id"])
+	assert.JSONEq(t, `{"api_key": "[REDACTED]", "api_key_id": "dummy", "name": "dummy"}`, resp["body"].(string))
+}
+
+func TestOptionSlog_Disabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"result": []}`)
+	})
+
+	var buf bytes.Buffer
+	OptionSlog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))(client)
+
+	_, err := client.GetAPIKeys(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestOptionSlog_Query(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"result": []}`)
+	})
+
+	var buf bytes.Buffer
+	OptionSlog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))(client)
+
+	req, err := client.NewRequest("GET", "/api_keys?limit=1&X-Amz-Signature=secret", nil)
+	require.NoError(t, err)
+	require.NoError(t, client.Do(context.TODO(), req, nil))
+
+	assert.NotContains(t, buf.String(), "secret")
+	assert.Contains(t, buf.String(), "limit=1")
+}
+
+func TestOptionSlog_LargeBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	certificates := make([]*SSOCertificate, 1000)
+	for i := range certificates {
+		certificates[i] = &SSOCertificate{ID: int64(i), PublicCertificate: "secret-" + strings.Repeat("x", 100)}
+	}
+	body, err := json.Marshal(certificates)
+	require.NoError(t, err)
+	require.Greater(t, len(body), maxLogBodySize)
+
+	mux.HandleFunc("/sso/integrations/dummy/certificates", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	})
+	mux.HandleFunc("/sso/certificates", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	var buf bytes.Buffer
+	OptionSlog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))(client)
+
+	r, err := client.GetSSOCertificates(context.TODO(), "dummy")
+	require.NoError(t, err)
+	require.Len(t, r, len(certificates))
+
+	_, err = client.CreateSSOCertificate(context.TODO(), &InputCreateSSOCertificate{
+		PublicCertificate: "secret-" + strings.Repeat("x", maxLogBodySize),
+		IntegrationID:     "dummy",
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "secret")
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var v map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &v))
+		assert.LessOrEqual(t, len(v["body"].(string)), maxLogBodySize)
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"empty", ``, ``},
+		{"not sensitive", `b=2&a=1`, `b=2&a=1`},
+		{
+			"signed url",
+			`X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Credential=secret&X-Amz-Signature=secret`,
+			`X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Credential=%5BREDACTED%5D&X-Amz-Signature=%5BREDACTED%5D`,
+		},
+		{"token", `token=secret&page=2`, `page=2&token=%5BREDACTED%5D`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactQuery(tt.query))
+		})
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty", ``, ``},
+		{"not json", `<html>bad gateway</html>`, `[REDACTED] (24 bytes)`},
+		{"truncated", `{"name": "dummy", "password": "sec`, `[REDACTED] (34 bytes)`},
+		{"not sensitive", `{"name": "dummy"}`, `{"name": "dummy"}`},
+		{
+			"nested",
+			`{"url": "https://example.com", "oauth_client_secret": "secret", "certificates": [{"x509certificate": "secret", "id": 1}], "Password": "secret"}`,
+			`{"Password":"[REDACTED]","certificates":[{"id":1,"x509certificate":"[REDACTED]"}],"oauth_client_secret":"[REDACTED]","url":"https://example.com"}`,
+		},
+		{
+			"credentials",
+			`{"enabled": true, "license_key": "secret", "new_password": "secret", "old_password": "secret"}`,
+			`{"enabled":true,"license_key":"[REDACTED]","new_password":"[REDACTED]","old_password":"[REDACTED]"}`,
+		},
+		{
+			"signed urls",
+			`{"job_id": "job-1", "upload_uri": "https://example.com/?X-Amz-Signature=secret"}`,
+			`{"job_id":"job-1","upload_uri":"[REDACTED]"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactBody([]byte(tt.body)))
+		})
+	}
+}
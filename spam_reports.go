@@ -0,0 +1,134 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+type SpamReport struct {
+	Created int64  `json:"created,omitempty"`
+	Email   string `json:"email,omitempty"`
+	IP      string `json:"ip,omitempty"`
+}
+
+type InputGetSpamReports struct {
+	StartTime int64
+	EndTime   int64
+	Limit     int
+	Offset    int
+	Email     string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/spam-reports-api/retrieve-all-spam-reports
+func (c *Client) GetSpamReports(ctx context.Context, input *InputGetSpamReports) ([]*SpamReport, error) {
+	u, err := url.Parse("/suppression/spam_reports")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.StartTime > 0 {
+		q.Set("start_time", strconv.FormatInt(input.StartTime, 10))
+	}
+	if input.EndTime > 0 {
+		q.Set("end_time", strconv.FormatInt(input.EndTime, 10))
+	}
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	if input.Email != "" {
+		q.Set("email", input.Email)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*SpamReport{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// AllSpamReports iterates over every entry, advancing offset by limit.
+func (c *Client) AllSpamReports(ctx context.Context, input *InputGetSpamReports) iter.Seq2[*SpamReport, error] {
+	in := InputGetSpamReports{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*SpamReport] {
+		in := in
+		return func(ctx context.Context) ([]*SpamReport, bool, error) {
+			r, err := c.GetSpamReports(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/spam-reports-api/retrieve-a-specific-spam-report
+func (c *Client) GetSpamReport(ctx context.Context, email string) ([]*SpamReport, error) {
+	path := fmt.Sprintf("/suppression/spam_reports/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*SpamReport{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputDeleteSpamReports struct {
+	DeleteAll bool     `json:"delete_all,omitempty"`
+	Emails    []string `json:"emails,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/spam-reports-api/delete-spam-reports
+func (c *Client) DeleteSpamReports(ctx context.Context, input *InputDeleteSpamReports) error {
+	req, err := c.NewRequest("DELETE", "/suppression/spam_reports", input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/spam-reports-api/delete-a-specific-spam-report
+func (c *Client) DeleteSpamReport(ctx context.Context, email string) error {
+	path := fmt.Sprintf("/suppression/spam_reports/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,401 @@
+package sendgrid
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// statsDateLayout is the layout of start_date and end_date of stats queries.
+const statsDateLayout = "2006-01-02"
+
+// metrics to sort stats sums by
+const (
+	StatsMetricBlocks           = "blocks"
+	StatsMetricBounceDrops      = "bounce_drops"
+	StatsMetricBounces          = "bounces"
+	StatsMetricClicks           = "clicks"
+	StatsMetricDeferred         = "deferred"
+	StatsMetricDelivered        = "delivered"
+	StatsMetricInvalidEmails    = "invalid_emails"
+	StatsMetricOpens            = "opens"
+	StatsMetricProcessed        = "processed"
+	StatsMetricRequests         = "requests"
+	StatsMetricSpamReportDrops  = "spam_report_drops"
+	StatsMetricSpamReports      = "spam_reports"
+	StatsMetricUniqueClicks     = "unique_clicks"
+	StatsMetricUniqueOpens      = "unique_opens"
+	StatsMetricUnsubscribeDrops = "unsubscribe_drops"
+	StatsMetricUnsubscribes     = "unsubscribes"
+)
+
+// StatMetrics holds the email metrics of a stat. Endpoints scoped to
+// geography, device, client, mailbox provider or browser only fill the
+// metrics that apply to them.
+type StatMetrics struct {
+	Blocks           int64 `json:"blocks,omitempty"`
+	BounceDrops      int64 `json:"bounce_drops,omitempty"`
+	Bounces          int64 `json:"bounces,omitempty"`
+	Clicks           int64 `json:"clicks,omitempty"`
+	Deferred         int64 `json:"deferred,omitempty"`
+	Delivered        int64 `json:"delivered,omitempty"`
+	Drops            int64 `json:"drops,omitempty"`
+	InvalidEmails    int64 `json:"invalid_emails,omitempty"`
+	Opens            int64 `json:"opens,omitempty"`
+	Processed        int64 `json:"processed,omitempty"`
+	Requests         int64 `json:"requests,omitempty"`
+	SpamReportDrops  int64 `json:"spam_report_drops,omitempty"`
+	SpamReports      int64 `json:"spam_reports,omitempty"`
+	UniqueClicks     int64 `json:"unique_clicks,omitempty"`
+	UniqueOpens      int64 `json:"unique_opens,omitempty"`
+	UnsubscribeDrops int64 `json:"unsubscribe_drops,omitempty"`
+	Unsubscribes     int64 `json:"unsubscribes,omitempty"`
+}
+
+type Stat struct {
+	// Type is e.g. "category", "subuser", "country", "device", "client", "mailbox_provider" or "browser".
+	Type      string       `json:"type,omitempty"`
+	Name      string       `json:"name,omitempty"`
+	FirstName string       `json:"first_name,omitempty"`
+	LastName  string       `json:"last_name,omitempty"`
+	Metrics   *StatMetrics `json:"metrics,omitempty"`
+}
+
+type DateStats struct {
+	Date  string  `json:"date,omitempty"`
+	Stats []*Stat `json:"stats,omitempty"`
+}
+
+// statsQuery sets the date range and paging parameters shared by the stats endpoints.
+func statsQuery(q url.Values, startDate, endDate time.Time, aggregatedBy string, limit, offset int) {
+	if !startDate.IsZero() {
+		q.Set("start_date", startDate.Format(statsDateLayout))
+	}
+	if !endDate.IsZero() {
+		q.Set("end_date", endDate.Format(statsDateLayout))
+	}
+	if aggregatedBy != "" {
+		q.Set("aggregated_by", aggregatedBy)
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		q.Set("offset", strconv.Itoa(offset))
+	}
+}
+
+func (c *Client) getDateStats(ctx context.Context, u *url.URL) ([]*DateStats, error) {
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*DateStats{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputGetStats struct {
+	StartDate time.Time
+	EndDate   time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/stats/retrieve-global-email-statistics
+func (c *Client) GetStats(ctx context.Context, input *InputGetStats) ([]*DateStats, error) {
+	u, err := url.Parse("/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
+
+type InputGetCategoryStats struct {
+	Categories []string
+	StartDate  time.Time
+	EndDate    time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/categories/retrieve-email-statistics-for-categories
+func (c *Client) GetCategoryStats(ctx context.Context, input *InputGetCategoryStats) ([]*DateStats, error) {
+	u, err := url.Parse("/categories/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	for _, category := range input.Categories {
+		q.Add("categories", category)
+	}
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
+
+type InputGetStatsSums struct {
+	// SortByMetric is one of the StatsMetric* constants.
+	SortByMetric string
+	// SortByDirection is "asc" or "desc" (default).
+	SortByDirection string
+	StartDate       time.Time
+	EndDate         time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+func (c *Client) getStatsSums(ctx context.Context, path string, input *InputGetStatsSums) (*DateStats, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.SortByMetric != "" {
+		q.Set("sort_by_metric", input.SortByMetric)
+	}
+	if input.SortByDirection != "" {
+		q.Set("sort_by_direction", input.SortByDirection)
+	}
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(DateStats)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/categories/retrieve-sums-of-email-stats-for-each-category
+func (c *Client) GetCategoryStatsSums(ctx context.Context, input *InputGetStatsSums) (*DateStats, error) {
+	return c.getStatsSums(ctx, "/categories/stats/sums", input)
+}
+
+type InputGetSubuserStats struct {
+	Subusers  []string
+	StartDate time.Time
+	EndDate   time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/subuser-statistics/retrieve-email-statistics-for-your-subusers
+func (c *Client) GetSubuserStats(ctx context.Context, input *InputGetSubuserStats) ([]*DateStats, error) {
+	u, err := url.Parse("/subusers/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	for _, subuser := range input.Subusers {
+		q.Add("subusers", subuser)
+	}
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/subuser-statistics/retrieve-the-totals-for-each-email-statistic-metric-for-all-subusers
+func (c *Client) GetSubuserStatsSums(ctx context.Context, input *InputGetStatsSums) (*DateStats, error) {
+	return c.getStatsSums(ctx, "/subusers/stats/sums", input)
+}
+
+type InputGetSubuserMonthlyStats struct {
+	// Date is any day of the month to retrieve.
+	Date    time.Time
+	Subuser string
+	// SortByMetric is one of the StatsMetric* constants.
+	SortByMetric string
+	// SortByDirection is "asc" or "desc" (default).
+	SortByDirection string
+	Limit           int
+	Offset          int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/subuser-monthly-statistics/retrieve-monthly-stats-for-all-subusers
+func (c *Client) GetSubuserMonthlyStats(ctx context.Context, input *InputGetSubuserMonthlyStats) (*DateStats, error) {
+	u, err := url.Parse("/subusers/stats/monthly")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if !input.Date.IsZero() {
+		q.Set("date", input.Date.Format(statsDateLayout))
+	}
+	if input.Subuser != "" {
+		q.Set("subuser", input.Subuser)
+	}
+	if input.SortByMetric != "" {
+		q.Set("sort_by_metric", input.SortByMetric)
+	}
+	if input.SortByDirection != "" {
+		q.Set("sort_by_direction", input.SortByDirection)
+	}
+	statsQuery(q, time.Time{}, time.Time{}, "", input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(DateStats)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputGetGeoStats struct {
+	// Country is "US" or "CA".
+	Country   string
+	StartDate time.Time
+	EndDate   time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/geo-stats/retrieve-email-statistics-by-country-and-state-province
+func (c *Client) GetGeoStats(ctx context.Context, input *InputGetGeoStats) ([]*DateStats, error) {
+	u, err := url.Parse("/geo/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.Country != "" {
+		q.Set("country", input.Country)
+	}
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
+
+type InputGetDeviceStats struct {
+	StartDate time.Time
+	EndDate   time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/stats/retrieve-email-statistics-by-device-type
+func (c *Client) GetDeviceStats(ctx context.Context, input *InputGetDeviceStats) ([]*DateStats, error) {
+	u, err := url.Parse("/devices/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
+
+type InputGetClientStats struct {
+	StartDate time.Time
+	EndDate   time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/clients-stats/retrieve-email-statistics-by-client-type
+func (c *Client) GetClientStats(ctx context.Context, input *InputGetClientStats) ([]*DateStats, error) {
+	u, err := url.Parse("/clients/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, 0, 0)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
+
+type InputGetMailboxProviderStats struct {
+	MailboxProviders []string
+	StartDate        time.Time
+	EndDate          time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/stats/retrieve-email-statistics-by-mailbox-provider
+func (c *Client) GetMailboxProviderStats(ctx context.Context, input *InputGetMailboxProviderStats) ([]*DateStats, error) {
+	u, err := url.Parse("/mailbox_providers/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	for _, provider := range input.MailboxProviders {
+		q.Add("mailbox_providers", provider)
+	}
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
+
+type InputGetBrowserStats struct {
+	Browsers  []string
+	StartDate time.Time
+	EndDate   time.Time
+	// AggregatedBy is AggregatedByDay (default), AggregatedByWeek or AggregatedByMonth.
+	AggregatedBy string
+	Limit        int
+	Offset       int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/stats/retrieve-email-statistics-by-browser
+func (c *Client) GetBrowserStats(ctx context.Context, input *InputGetBrowserStats) ([]*DateStats, error) {
+	u, err := url.Parse("/browsers/stats")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	for _, browser := range input.Browsers {
+		q.Add("browsers", browser)
+	}
+	statsQuery(q, input.StartDate, input.EndDate, input.AggregatedBy, input.Limit, input.Offset)
+	u.RawQuery = q.Encode()
+
+	return c.getDateStats(ctx, u)
+}
@@ -0,0 +1,374 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "aggregated_by=week&end_date=2026-01-31&start_date=2026-01-01", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [{"metrics": {"delivered": 10, "opens": 5, "unique_opens": 4}}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetStats(context.TODO(), &InputGetStats{
+		StartDate:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		AggregatedBy: AggregatedByWeek,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{
+			Date:  "2026-01-01",
+			Stats: []*Stat{{Metrics: &StatMetrics{Delivered: 10, Opens: 5, UniqueOpens: 4}}},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetStats_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.GetStats(context.TODO(), &InputGetStats{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetCategoryStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/categories/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, []string{"news", "promo"}, r.URL.Query()["categories"])
+		assert.Equal(t, "2026-01-01", r.URL.Query().Get("start_date"))
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [
+				{"type": "category", "name": "news", "metrics": {"clicks": 2}},
+				{"type": "category", "name": "promo", "metrics": {"clicks": 3}}
+			]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetCategoryStats(context.TODO(), &InputGetCategoryStats{
+		Categories: []string{"news", "promo"},
+		StartDate:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{
+			Date: "2026-01-01",
+			Stats: []*Stat{
+				{Type: "category", Name: "news", Metrics: &StatMetrics{Clicks: 2}},
+				{Type: "category", Name: "promo", Metrics: &StatMetrics{Clicks: 3}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetCategoryStatsSums(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/categories/stats/sums", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "limit=5&sort_by_direction=asc&sort_by_metric=opens&start_date=2026-01-01", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `{
+			"date": "2026-01-01",
+			"stats": [{"type": "category", "name": "news", "metrics": {"opens": 1}}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetCategoryStatsSums(context.TODO(), &InputGetStatsSums{
+		SortByMetric:    StatsMetricOpens,
+		SortByDirection: "asc",
+		StartDate:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Limit:           5,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &DateStats{
+		Date:  "2026-01-01",
+		Stats: []*Stat{{Type: "category", Name: "news", Metrics: &StatMetrics{Opens: 1}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSubuserStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, []string{"sub1", "sub2"}, r.URL.Query()["subusers"])
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [{"type": "subuser", "name": "sub1", "metrics": {"requests": 8}}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSubuserStats(context.TODO(), &InputGetSubuserStats{
+		Subusers:  []string{"sub1", "sub2"},
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{Date: "2026-01-01", Stats: []*Stat{{Type: "subuser", Name: "sub1", Metrics: &StatMetrics{Requests: 8}}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSubuserStatsSums(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers/stats/sums", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"date": "2026-01-01", "stats": [{"type": "subuser", "name": "sub1", "metrics": {"bounces": 1}}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSubuserStatsSums(context.TODO(), &InputGetStatsSums{})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &DateStats{
+		Date:  "2026-01-01",
+		Stats: []*Stat{{Type: "subuser", Name: "sub1", Metrics: &StatMetrics{Bounces: 1}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSubuserMonthlyStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers/stats/monthly", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "date=2026-03-15&sort_by_metric=delivered&subuser=sub1", r.URL.RawQuery)
+		if _, err := fmt.Fprint(w, `{
+			"date": "2026-03-01",
+			"stats": [{"type": "subuser", "name": "sub1", "first_name": "Taro", "last_name": "Yamada", "metrics": {"delivered": 100}}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSubuserMonthlyStats(context.TODO(), &InputGetSubuserMonthlyStats{
+		Date:         time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		Subuser:      "sub1",
+		SortByMetric: StatsMetricDelivered,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &DateStats{
+		Date: "2026-03-01",
+		Stats: []*Stat{
+			{Type: "subuser", Name: "sub1", FirstName: "Taro", LastName: "Yamada", Metrics: &StatMetrics{Delivered: 100}},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetGeoStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/geo/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "US", r.URL.Query().Get("country"))
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [{"type": "province", "name": "CA", "metrics": {"unique_clicks": 1}}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetGeoStats(context.TODO(), &InputGetGeoStats{Country: "US"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{Date: "2026-01-01", Stats: []*Stat{{Type: "province", Name: "CA", Metrics: &StatMetrics{UniqueClicks: 1}}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetDeviceStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/devices/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [{"type": "device", "name": "Webmail", "metrics": {"opens": 2}}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetDeviceStats(context.TODO(), &InputGetDeviceStats{})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{Date: "2026-01-01", Stats: []*Stat{{Type: "device", Name: "Webmail", Metrics: &StatMetrics{Opens: 2}}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetClientStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [{"type": "client", "name": "Gmail", "metrics": {"unique_opens": 3}}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetClientStats(context.TODO(), &InputGetClientStats{})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{Date: "2026-01-01", Stats: []*Stat{{Type: "client", Name: "Gmail", Metrics: &StatMetrics{UniqueOpens: 3}}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetMailboxProviderStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mailbox_providers/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, []string{"Gmail"}, r.URL.Query()["mailbox_providers"])
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [{"type": "mailbox_provider", "name": "Gmail", "metrics": {"deferred": 1, "drops": 2}}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetMailboxProviderStats(context.TODO(), &InputGetMailboxProviderStats{
+		MailboxProviders: []string{"Gmail"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{Date: "2026-01-01", Stats: []*Stat{{Type: "mailbox_provider", Name: "Gmail", Metrics: &StatMetrics{Deferred: 1, Drops: 2}}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetBrowserStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/browsers/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, []string{"Chrome", "Firefox"}, r.URL.Query()["browsers"])
+		if _, err := fmt.Fprint(w, `[
+			{"date": "2026-01-01", "stats": [{"type": "browser", "name": "Chrome", "metrics": {"clicks": 6}}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetBrowserStats(context.TODO(), &InputGetBrowserStats{
+		Browsers: []string{"Chrome", "Firefox"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*DateStats{
+		{Date: "2026-01-01", Stats: []*Stat{{Type: "browser", Name: "Chrome", Metrics: &StatMetrics{Clicks: 6}}}},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -51,6 +52,29 @@ func (c *Client) GetSubusers(ctx context.Context, input *InputGetSubusers) ([]*S
 	return r, nil
 }
 
+// AllSubusers iterates over every subuser, advancing offset by limit.
+func (c *Client) AllSubusers(ctx context.Context, input *InputGetSubusers) iter.Seq2[*Subuser, error] {
+	in := InputGetSubusers{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*Subuser] {
+		in := in
+		return func(ctx context.Context) ([]*Subuser, bool, error) {
+			r, err := c.GetSubusers(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
 type Reputation struct {
 	Reputation float64 `json:"reputation,omitempty"`
 	Username   string  `json:"username,omitempty"`
@@ -254,3 +254,85 @@ func TestDeleteSubuser_Failed(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestAllSubusers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		body := `[{"id":1},{"id":2}]`
+		if r.URL.Query().Get("offset") == "2" {
+			body = `[{"id":3}]`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []int64{}
+	for subuser, err := range client.AllSubusers(context.TODO(), &InputGetSubusers{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, subuser.ID)
+	}
+
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
+
+func TestAllSubusers_Twice(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/subusers", func(w http.ResponseWriter, r *http.Request) {
+		body := `[{"id":1},{"id":2}]`
+		if r.URL.Query().Get("offset") == "2" {
+			body = `[]`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	subusers := client.AllSubusers(context.TODO(), &InputGetSubusers{Limit: 2})
+	for pass := range 2 {
+		ids := []int64{}
+		for subuser, err := range subusers {
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			ids = append(ids, subuser.ID)
+		}
+
+		if want := []int64{1, 2}; !reflect.DeepEqual(want, ids) {
+			t.Fatalf("pass %d: %v, want %v", pass, ids, want)
+		}
+	}
+}
+
+func TestAllSubusers_Break(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/subusers", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if _, err := fmt.Fprint(w, `[{"id":1},{"id":2}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	for _, err := range client.AllSubusers(context.TODO(), &InputGetSubusers{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		break
+	}
+
+	if calls != 1 {
+		t.Fatalf("calls: %d, want 1", calls)
+	}
+}
@@ -0,0 +1,141 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+type GlobalSuppression struct {
+	Created int64  `json:"created,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+type InputGetGlobalSuppressions struct {
+	StartTime int64
+	EndTime   int64
+	Limit     int
+	Offset    int
+	Email     string
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-global-suppressions/retrieve-all-global-suppressions
+func (c *Client) GetGlobalSuppressions(ctx context.Context, input *InputGetGlobalSuppressions) ([]*GlobalSuppression, error) {
+	u, err := url.Parse("/suppression/unsubscribes")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.StartTime > 0 {
+		q.Set("start_time", strconv.FormatInt(input.StartTime, 10))
+	}
+	if input.EndTime > 0 {
+		q.Set("end_time", strconv.FormatInt(input.EndTime, 10))
+	}
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	if input.Email != "" {
+		q.Set("email", input.Email)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*GlobalSuppression{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// AllGlobalSuppressions iterates over every global suppression, advancing offset by limit.
+func (c *Client) AllGlobalSuppressions(ctx context.Context, input *InputGetGlobalSuppressions) iter.Seq2[*GlobalSuppression, error] {
+	in := InputGetGlobalSuppressions{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[*GlobalSuppression] {
+		in := in
+		return func(ctx context.Context) ([]*GlobalSuppression, bool, error) {
+			r, err := c.GetGlobalSuppressions(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r)
+			return r, len(r) == in.Limit, nil
+		}
+	})
+}
+
+type OutputGetGlobalSuppression struct {
+	RecipientEmail string `json:"recipient_email,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-global-suppressions/retrieve-a-global-suppression
+func (c *Client) GetGlobalSuppression(ctx context.Context, email string) (*OutputGetGlobalSuppression, error) {
+	path := fmt.Sprintf("/asm/suppressions/global/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetGlobalSuppression)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputAddGlobalSuppressions struct {
+	RecipientEmails []string `json:"recipient_emails"`
+}
+
+type OutputAddGlobalSuppressions struct {
+	RecipientEmails []string `json:"recipient_emails,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-global-suppressions/add-recipient-addresses-to-the-global-suppression-group
+func (c *Client) AddGlobalSuppressions(ctx context.Context, input *InputAddGlobalSuppressions) (*OutputAddGlobalSuppressions, error) {
+	req, err := c.NewRequest("POST", "/asm/suppressions/global", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputAddGlobalSuppressions)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-global-suppressions/delete-a-global-suppression
+func (c *Client) DeleteGlobalSuppression(ctx context.Context, email string) error {
+	path := fmt.Sprintf("/asm/suppressions/global/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+)
+
+func TestGetGlobalSuppressions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/unsubscribes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "email=a%40example.com&start_time=1700000000" {
+			t.Errorf("query: %v", got)
+		}
+		if _, err := fmt.Fprint(w, `[{"created":1700000000,"email":"a@example.com"}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetGlobalSuppressions(context.TODO(), &InputGetGlobalSuppressions{
+		StartTime: 1700000000,
+		Email:     "a@example.com",
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*GlobalSuppression{{Created: 1700000000, Email: "a@example.com"}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetGlobalSuppressions_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/unsubscribes", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetGlobalSuppressions(context.TODO(), &InputGetGlobalSuppressions{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAllGlobalSuppressions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/suppression/unsubscribes", func(w http.ResponseWriter, r *http.Request) {
+		body := `[{"email":"a@example.com"}]`
+		if r.URL.Query().Get("offset") == "1" {
+			body = `[]`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	emails := []string{}
+	for v, err := range client.AllGlobalSuppressions(context.TODO(), &InputGetGlobalSuppressions{Limit: 1}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		emails = append(emails, v.Email)
+	}
+
+	if want := []string{"a@example.com"}; !reflect.DeepEqual(want, emails) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, emails)))
+	}
+}
+
+func TestGetGlobalSuppression(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/global/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"recipient_email":"a@example.com"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetGlobalSuppression(context.TODO(), "a@example.com")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetGlobalSuppression{RecipientEmail: "a@example.com"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetGlobalSuppression_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/global/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetGlobalSuppression(context.TODO(), "a@example.com")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAddGlobalSuppressions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/global", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{"recipient_emails":["a@example.com"]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.AddGlobalSuppressions(context.TODO(), &InputAddGlobalSuppressions{
+		RecipientEmails: []string{"a@example.com"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputAddGlobalSuppressions{RecipientEmails: []string{"a@example.com"}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestAddGlobalSuppressions_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/global", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.AddGlobalSuppressions(context.TODO(), &InputAddGlobalSuppressions{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestDeleteGlobalSuppression(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/global/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteGlobalSuppression(context.TODO(), "a@example.com")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestDeleteGlobalSuppression_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/global/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	err := client.DeleteGlobalSuppression(context.TODO(), "a@example.com")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
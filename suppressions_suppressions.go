@@ -0,0 +1,135 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/retrieve-all-suppressions-for-a-suppression-group
+func (c *Client) GetSuppressionGroupSuppressions(ctx context.Context, groupID int64) ([]string, error) {
+	path := fmt.Sprintf("/asm/groups/%s/suppressions", strconv.FormatInt(groupID, 10))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []string{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type InputAddSuppressionGroupSuppressions struct {
+	RecipientEmails []string `json:"recipient_emails"`
+}
+
+type OutputAddSuppressionGroupSuppressions struct {
+	RecipientEmails []string `json:"recipient_emails,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/add-suppressions-to-a-suppression-group
+func (c *Client) AddSuppressionGroupSuppressions(ctx context.Context, groupID int64, input *InputAddSuppressionGroupSuppressions) (*OutputAddSuppressionGroupSuppressions, error) {
+	path := fmt.Sprintf("/asm/groups/%s/suppressions", strconv.FormatInt(groupID, 10))
+
+	req, err := c.NewRequest("POST", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputAddSuppressionGroupSuppressions)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputSearchSuppressionGroupSuppressions struct {
+	RecipientEmails []string `json:"recipient_emails"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/search-for-suppressions-within-a-group
+func (c *Client) SearchSuppressionGroupSuppressions(ctx context.Context, groupID int64, input *InputSearchSuppressionGroupSuppressions) ([]string, error) {
+	path := fmt.Sprintf("/asm/groups/%s/suppressions/search", strconv.FormatInt(groupID, 10))
+
+	req, err := c.NewRequest("POST", path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []string{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/delete-a-suppression-from-a-suppression-group
+func (c *Client) DeleteSuppressionGroupSuppression(ctx context.Context, groupID int64, email string) error {
+	path := fmt.Sprintf("/asm/groups/%s/suppressions/%s", strconv.FormatInt(groupID, 10), url.PathEscape(email))
+
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+type Suppression struct {
+	Email     string `json:"email,omitempty"`
+	GroupID   int64  `json:"group_id,omitempty"`
+	GroupName string `json:"group_name,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/retrieve-all-suppressions
+func (c *Client) GetSuppressions(ctx context.Context) ([]*Suppression, error) {
+	req, err := c.NewRequest("GET", "/asm/suppressions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*Suppression{}
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type OutputGetSuppressionsByEmail struct {
+	Suppressions []*EmailSuppression `json:"suppressions,omitempty"`
+}
+
+type EmailSuppression struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IsDefault   bool   `json:"is_default,omitempty"`
+	Suppressed  bool   `json:"suppressed,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/retrieve-all-suppression-groups-for-an-email-address
+func (c *Client) GetSuppressionsByEmail(ctx context.Context, email string) (*OutputGetSuppressionsByEmail, error) {
+	path := fmt.Sprintf("/asm/suppressions/%s", url.PathEscape(email))
+
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetSuppressionsByEmail)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
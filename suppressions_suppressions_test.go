@@ -0,0 +1,253 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+)
+
+func TestGetSuppressionGroupSuppressions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `["a@example.com","b@example.com"]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSuppressionGroupSuppressions(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []string{"a@example.com", "b@example.com"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSuppressionGroupSuppressions_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetSuppressionGroupSuppressions(context.TODO(), 1)
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAddSuppressionGroupSuppressions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		if _, err := fmt.Fprint(w, `{"recipient_emails":["a@example.com"]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.AddSuppressionGroupSuppressions(context.TODO(), 1, &InputAddSuppressionGroupSuppressions{
+		RecipientEmails: []string{"a@example.com"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputAddSuppressionGroupSuppressions{RecipientEmails: []string{"a@example.com"}}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestAddSuppressionGroupSuppressions_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.AddSuppressionGroupSuppressions(context.TODO(), 1, &InputAddSuppressionGroupSuppressions{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestSearchSuppressionGroupSuppressions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if _, err := fmt.Fprint(w, `["a@example.com"]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.SearchSuppressionGroupSuppressions(context.TODO(), 1, &InputSearchSuppressionGroupSuppressions{
+		RecipientEmails: []string{"a@example.com", "b@example.com"},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []string{"a@example.com"}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestSearchSuppressionGroupSuppressions_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions/search", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.SearchSuppressionGroupSuppressions(context.TODO(), 1, &InputSearchSuppressionGroupSuppressions{})
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestDeleteSuppressionGroupSuppression(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteSuppressionGroupSuppression(context.TODO(), 1, "a@example.com")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestDeleteSuppressionGroupSuppression_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/groups/1/suppressions/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	err := client.DeleteSuppressionGroupSuppression(context.TODO(), 1, "a@example.com")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetSuppressions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[
+			{"email":"a@example.com","group_id":1,"group_name":"weekly","created_at":1700000000}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSuppressions(context.TODO())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*Suppression{
+		{
+			Email:     "a@example.com",
+			GroupID:   1,
+			GroupName: "weekly",
+			CreatedAt: 1700000000,
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSuppressions_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetSuppressions(context.TODO())
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetSuppressionsByEmail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `{"suppressions":[
+			{"id":1,"name":"weekly","description":"weekly news","is_default":true,"suppressed":true}
+		]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSuppressionsByEmail(context.TODO(), "a@example.com")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := &OutputGetSuppressionsByEmail{
+		Suppressions: []*EmailSuppression{
+			{
+				ID:          1,
+				Name:        "weekly",
+				Description: "weekly news",
+				IsDefault:   true,
+				Suppressed:  true,
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSuppressionsByEmail_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/asm/suppressions/a@example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetSuppressionsByEmail(context.TODO(), "a@example.com")
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -71,8 +72,28 @@ type OutputGetTeammates struct {
 	Teammates []Teammate `json:"result,omitempty"`
 }
 
-func (c *Client) GetTeammates(ctx context.Context) (*OutputGetTeammates, error) {
-	req, err := c.NewRequest("GET", "/teammates", nil)
+type InputGetTeammates struct {
+	Limit  int
+	Offset int
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-all-teammates
+func (c *Client) GetTeammates(ctx context.Context, input *InputGetTeammates) (*OutputGetTeammates, error) {
+	u, err := url.Parse("/teammates")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if input.Limit > 0 {
+		q.Set("limit", strconv.Itoa(input.Limit))
+	}
+	if input.Offset > 0 {
+		q.Set("offset", strconv.Itoa(input.Offset))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +106,29 @@ func (c *Client) GetTeammates(ctx context.Context) (*OutputGetTeammates, error)
 	return r, nil
 }
 
+// AllTeammates iterates over every teammate, advancing offset by limit.
+func (c *Client) AllTeammates(ctx context.Context, input *InputGetTeammates) iter.Seq2[Teammate, error] {
+	in := InputGetTeammates{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[Teammate] {
+		in := in
+		return func(ctx context.Context) ([]Teammate, bool, error) {
+			r, err := c.GetTeammates(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.Offset += len(r.Teammates)
+			return r.Teammates, len(r.Teammates) == in.Limit, nil
+		}
+	})
+}
+
 type PendingTeammate struct {
 	Email          string   `json:"email,omitempty"`
 	Scopes         []string `json:"scopes,omitempty"`
@@ -250,6 +294,7 @@ func (c *Client) GetTeammateSubuserAccess(ctx context.Context, teammateName stri
 	if input.Username != "" {
 		q.Set("username", input.Username)
 	}
+	u.RawQuery = q.Encode()
 
 	req, err := c.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -264,6 +309,31 @@ func (c *Client) GetTeammateSubuserAccess(ctx context.Context, teammateName stri
 	return r, nil
 }
 
+// AllTeammateSubuserAccess iterates over every subuser a teammate can access,
+// following _metadata.next_params.after_subuser_id across pages.
+func (c *Client) AllTeammateSubuserAccess(ctx context.Context, teammateName string, input *InputGetTeammateSubuserAccess) iter.Seq2[SubuserAccess, error] {
+	in := InputGetTeammateSubuserAccess{}
+	if input != nil {
+		in = *input
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[SubuserAccess] {
+		in := in
+		return func(ctx context.Context) ([]SubuserAccess, bool, error) {
+			r, err := c.GetTeammateSubuserAccess(ctx, teammateName, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			prev := in.AfterSubuserID
+			in.AfterSubuserID = r.Metadata.NextParams.AfterSubuserID
+			return r.SubuserAccess, in.AfterSubuserID != 0 && in.AfterSubuserID != prev, nil
+		}
+	})
+}
+
 type InputCreateSSOTeammate struct {
 	Email                      string               `json:"email"`
 	FirstName                  string               `json:"first_name"`
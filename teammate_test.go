@@ -105,7 +105,10 @@ func TestGetTeammates(t *testing.T) {
 		}
 	})
 
-	expected, err := client.GetTeammates(context.TODO())
+	expected, err := client.GetTeammates(context.TODO(), &InputGetTeammates{
+		Limit:  1,
+		Offset: 1,
+	})
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err)
 		return
@@ -144,7 +147,7 @@ func TestGetTeammates_Failed(t *testing.T) {
 		w.WriteHeader(http.StatusInternalServerError)
 	})
 
-	_, err := client.GetTeammates(context.TODO())
+	_, err := client.GetTeammates(context.TODO(), &InputGetTeammates{})
 	if err == nil {
 		t.Fatal("expected an error but got none")
 	}
@@ -627,3 +630,59 @@ func TestUpdateSSOTeammate_Failed(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestAllTeammateSubuserAccess(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/teammates/dummy/subuser_access", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		body := `{"subuser_access":[{"id":1},{"id":2}],"_metadata":{"next_params":{"limit":2,"after_subuser_id":2}}}`
+		if r.URL.Query().Get("after_subuser_id") == "2" {
+			body = `{"subuser_access":[{"id":3}],"_metadata":{"next_params":{"limit":2}}}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []int64{}
+	for access, err := range client.AllTeammateSubuserAccess(context.TODO(), "dummy", &InputGetTeammateSubuserAccess{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, access.ID)
+	}
+
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+	}
+}
+
+func TestAllTeammates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/teammates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		body := `{"result":[{"username":"a"},{"username":"b"}]}`
+		if r.URL.Query().Get("offset") == "2" {
+			body = `{"result":[{"username":"c"}]}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	usernames := []string{}
+	for teammate, err := range client.AllTeammates(context.TODO(), &InputGetTeammates{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		usernames = append(usernames, teammate.Username)
+	}
+
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(want, usernames) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, usernames)))
+	}
+}
@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -108,6 +109,29 @@ func (c *Client) GetTemplates(ctx context.Context, input *InputGetTemplates) (*O
 	return r, nil
 }
 
+// AllTemplates iterates over every template, following _metadata.next across pages.
+func (c *Client) AllTemplates(ctx context.Context, input *InputGetTemplates) iter.Seq2[Template, error] {
+	in := InputGetTemplates{}
+	if input != nil {
+		in = *input
+	}
+	if in.PageSize == 0 {
+		in.PageSize = defaultPageSize
+	}
+
+	return paginate(ctx, func() pageFetcher[Template] {
+		in := in
+		return func(ctx context.Context) ([]Template, bool, error) {
+			r, err := c.GetTemplates(ctx, &in)
+			if err != nil {
+				return nil, false, err
+			}
+			in.PageToken = pageToken(r.Metadata.Next)
+			return r.Templates, in.PageToken != "", nil
+		}
+	})
+}
+
 type InputCreateTemplate struct {
 	Name       string `json:"name,omitempty"`
 	Generation string `json:"generation,omitempty"`
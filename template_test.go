@@ -390,3 +390,79 @@ func TestDeleteTemplate_Failed(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestAllTemplates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("page_size"); got != "100" {
+			t.Errorf("page_size: %v, want 100", got)
+		}
+		body := `{"result":[{"id":"d-1"},{"id":"d-2"}],"_metadata":{"next":"https://api.sendgrid.com/v3/templates?page_size=100&page_token=token"}}`
+		if r.URL.Query().Get("page_token") == "token" {
+			body = `{"result":[{"id":"d-3"}],"_metadata":{}}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ids := []string{}
+	for template, err := range client.AllTemplates(context.TODO(), &InputGetTemplates{Generations: "dynamic"}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		ids = append(ids, template.ID)
+	}
+
+	if want := []string{"d-1", "d-2", "d-3"}; !reflect.DeepEqual(want, ids) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+	}
+}
+
+func TestAllTemplates_Twice(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		body := `{"result":[{"id":"d-1"}],"_metadata":{"next":"https://api.sendgrid.com/v3/templates?page_size=100&page_token=token"}}`
+		if r.URL.Query().Get("page_token") == "token" {
+			body = `{"result":[{"id":"d-2"}],"_metadata":{}}`
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	templates := client.AllTemplates(context.TODO(), nil)
+	for range 2 {
+		ids := []string{}
+		for template, err := range templates {
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			ids = append(ids, template.ID)
+		}
+
+		if want := []string{"d-1", "d-2"}; !reflect.DeepEqual(want, ids) {
+			t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, ids)))
+		}
+	}
+}
+
+func TestAllTemplates_Failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	for _, err := range client.AllTemplates(context.TODO(), nil) {
+		if err == nil {
+			t.Fatal("expected an error but got none")
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package sendgrid
+
+import (
+	"context"
+)
+
+type OutputGetUserAccount struct {
+	// Type is "free" or "paid".
+	Type       string  `json:"type,omitempty"`
+	Reputation float64 `json:"reputation,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/get-a-users-account-information
+func (c *Client) GetUserAccount(ctx context.Context) (*OutputGetUserAccount, error) {
+	req, err := c.NewRequest("GET", "/user/account", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetUserAccount)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type UserProfile struct {
+	Address   string `json:"address,omitempty"`
+	Address2  string `json:"address2,omitempty"`
+	City      string `json:"city,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Country   string `json:"country,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	State     string `json:"state,omitempty"`
+	Website   string `json:"website,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/get-a-users-profile
+func (c *Client) GetUserProfile(ctx context.Context) (*UserProfile, error) {
+	req, err := c.NewRequest("GET", "/user/profile", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(UserProfile)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateUserProfile struct {
+	Address   string `json:"address,omitempty"`
+	Address2  string `json:"address2,omitempty"`
+	City      string `json:"city,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Country   string `json:"country,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	State     string `json:"state,omitempty"`
+	Website   string `json:"website,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/update-a-users-profile
+func (c *Client) UpdateUserProfile(ctx context.Context, input *InputUpdateUserProfile) (*UserProfile, error) {
+	req, err := c.NewRequest("PATCH", "/user/profile", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(UserProfile)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputGetUserEmail struct {
+	Email string `json:"email,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/retrieve-your-account-email-address
+func (c *Client) GetUserEmail(ctx context.Context) (*OutputGetUserEmail, error) {
+	req, err := c.NewRequest("GET", "/user/email", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetUserEmail)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateUserEmail struct {
+	Email string `json:"email"`
+}
+
+type OutputUpdateUserEmail struct {
+	Email string `json:"email,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/update-your-account-email-address
+func (c *Client) UpdateUserEmail(ctx context.Context, input *InputUpdateUserEmail) (*OutputUpdateUserEmail, error) {
+	req, err := c.NewRequest("PUT", "/user/email", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateUserEmail)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type OutputGetUserUsername struct {
+	Username string `json:"username,omitempty"`
+	UserID   int64  `json:"user_id,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/retrieve-your-username
+func (c *Client) GetUserUsername(ctx context.Context) (*OutputGetUserUsername, error) {
+	req, err := c.NewRequest("GET", "/user/username", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetUserUsername)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateUserUsername struct {
+	Username string `json:"username"`
+}
+
+type OutputUpdateUserUsername struct {
+	Username string `json:"username,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/update-your-username
+func (c *Client) UpdateUserUsername(ctx context.Context, input *InputUpdateUserUsername) (*OutputUpdateUserUsername, error) {
+	req, err := c.NewRequest("PUT", "/user/username", input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputUpdateUserUsername)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type InputUpdateUserPassword struct {
+	NewPassword string `json:"new_password"`
+	OldPassword string `json:"old_password"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/update-your-password
+func (c *Client) UpdateUserPassword(ctx context.Context, input *InputUpdateUserPassword) error {
+	req, err := c.NewRequest("PUT", "/user/password", input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+type OutputGetUserCredits struct {
+	Remain         int64  `json:"remain,omitempty"`
+	Total          int64  `json:"total,omitempty"`
+	Overage        int64  `json:"overage,omitempty"`
+	Used           int64  `json:"used,omitempty"`
+	LastReset      string `json:"last_reset,omitempty"`
+	NextReset      string `json:"next_reset,omitempty"`
+	ResetFrequency string `json:"reset_frequency,omitempty"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/users-api/retrieve-your-credit-balance
+func (c *Client) GetUserCredits(ctx context.Context) (*OutputGetUserCredits, error) {
+	req, err := c.NewRequest("GET", "/user/credits", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(OutputGetUserCredits)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}